@@ -0,0 +1,176 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	rpctypes "github.com/tharsis/ethermint/rpc/ethereum/types"
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+const (
+	// KeyPrefixTxHash is the prefix of the eth tx hash -> TxResult entries
+	KeyPrefixTxHash = 1
+	// KeyPrefixTxIndex is the prefix of the (height, eth tx index) -> eth tx hash entries
+	KeyPrefixTxIndex = 2
+	// KeyPrefixLastIndexedBlock is the key of the last indexed block height
+	KeyPrefixLastIndexedBlock = 3
+	// KeyPrefixFirstIndexedBlock is the key of the first indexed block height
+	KeyPrefixFirstIndexedBlock = 4
+)
+
+var _ ethermint.EVMTxIndexer = &KVIndexer{}
+
+// KVIndexer implements an eth tx indexer on a KV db.
+type KVIndexer struct {
+	db        dbm.DB
+	logger    log.Logger
+	clientCtx client.Context
+}
+
+// NewKVIndexer creates the KVIndexer
+func NewKVIndexer(db dbm.DB, logger log.Logger, clientCtx client.Context) *KVIndexer {
+	return &KVIndexer{db, logger, clientCtx}
+}
+
+// IndexBlock indexes all the eth txs in a block through the following steps:
+// - Iterates over all of the Txs in the block
+// - Parses the eth tx infos from the events of the successful txs
+// - Records the eth tx index and the cumulative gas used in the block
+// - Indexes the logs by position, address and topics
+// - Stores the TxResults, the logs and the height markers in a single batch
+func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	height := block.Header.Height
+
+	ethTxs, err := rpctypes.ParseBlockEthTxs(kv.clientCtx.TxConfig.TxDecoder(), block, txResults)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "IndexBlock %d", height)
+	}
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	for _, ethTx := range ethTxs {
+		if err := saveTxResult(batch, common.HexToHash(ethTx.Msg.Hash), &ethTx.Result); err != nil {
+			return sdkerrors.Wrapf(err, "failed to index eth tx %s", ethTx.Msg.Hash)
+		}
+	}
+
+	if err := saveBlockLogs(batch, height, txResults); err != nil {
+		return sdkerrors.Wrapf(err, "failed to index the logs of block %d", height)
+	}
+
+	if err := kv.saveIndexedHeight(batch, height); err != nil {
+		return sdkerrors.Wrapf(err, "failed to save the indexed height %d", height)
+	}
+
+	return batch.Write()
+}
+
+// LastIndexedBlock returns the last block height indexed, -1 if the db is empty.
+func (kv *KVIndexer) LastIndexedBlock() (int64, error) {
+	return kv.loadHeight(KeyPrefixLastIndexedBlock)
+}
+
+// FirstIndexedBlock returns the first block height indexed, -1 if the db is empty.
+func (kv *KVIndexer) FirstIndexedBlock() (int64, error) {
+	return kv.loadHeight(KeyPrefixFirstIndexedBlock)
+}
+
+// GetByTxHash finds the eth tx by its hash.
+func (kv *KVIndexer) GetByTxHash(hash common.Hash) (*ethermint.TxResult, error) {
+	bz, err := kv.db.Get(TxHashKey(hash))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("tx not found, hash: %s", hash.Hex())
+	}
+	var txKey ethermint.TxResult
+	if err := txKey.Unmarshal(bz); err != nil {
+		return nil, sdkerrors.Wrapf(err, "GetByTxHash %s", hash.Hex())
+	}
+	return &txKey, nil
+}
+
+// GetByBlockAndIndex finds the eth tx by the block height and the eth tx index.
+func (kv *KVIndexer) GetByBlockAndIndex(blockNumber int64, txIndex int32) (*ethermint.TxResult, error) {
+	bz, err := kv.db.Get(TxIndexKey(blockNumber, txIndex))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "GetByBlockAndIndex %d %d", blockNumber, txIndex)
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("tx not found, block: %d, eth-index: %d", blockNumber, txIndex)
+	}
+	return kv.GetByTxHash(common.BytesToHash(bz))
+}
+
+// saveIndexedHeight extends the range of the indexed heights with the given
+// height, blocks without eth txs are recorded too so they are not re-indexed.
+func (kv *KVIndexer) saveIndexedHeight(batch dbm.Batch, height int64) error {
+	last, err := kv.LastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if height > last {
+		if err := batch.Set([]byte{KeyPrefixLastIndexedBlock}, sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+			return err
+		}
+	}
+
+	first, err := kv.FirstIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if first == -1 || height < first {
+		if err := batch.Set([]byte{KeyPrefixFirstIndexedBlock}, sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadHeight returns the height stored under the given key, -1 if not found.
+func (kv *KVIndexer) loadHeight(key byte) (int64, error) {
+	bz, err := kv.db.Get([]byte{key})
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) == 0 {
+		return -1, nil
+	}
+	return int64(sdk.BigEndianToUint64(bz)), nil
+}
+
+// TxHashKey returns the key for db entry: `tx hash -> tx result struct`
+func TxHashKey(hash common.Hash) []byte {
+	return append([]byte{KeyPrefixTxHash}, hash.Bytes()...)
+}
+
+// TxIndexKey returns the key for db entry: `(block number, tx index) -> tx hash`
+func TxIndexKey(blockNumber int64, txIndex int32) []byte {
+	bz1 := sdk.Uint64ToBigEndian(uint64(blockNumber))
+	bz2 := sdk.Uint64ToBigEndian(uint64(txIndex))
+	return append(append([]byte{KeyPrefixTxIndex}, bz1...), bz2...)
+}
+
+// saveTxResult index the txResult into the kv db batch
+func saveTxResult(batch dbm.Batch, txHash common.Hash, txResult *ethermint.TxResult) error {
+	bz, err := txResult.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := batch.Set(TxHashKey(txHash), bz); err != nil {
+		return err
+	}
+	return batch.Set(TxIndexKey(txResult.Height, txResult.EthTxIndex), txHash.Bytes())
+}
@@ -0,0 +1,270 @@
+package indexer_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tharsis/ethermint/app"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/encoding"
+	"github.com/tharsis/ethermint/indexer"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func TestKVIndexer(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := evmtypes.NewTx(nil, 0, &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil)
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := common.HexToHash(tx.Hash)
+
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig)
+
+	// build cosmos-sdk wrapper tx
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	ethTxEvent := func(attrs ...abci.EventAttribute) abci.Event {
+		return abci.Event{
+			Type: evmtypes.EventTypeEthereumTx,
+			Attributes: append([]abci.EventAttribute{
+				{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(txHash.Hex())},
+				{Key: []byte(evmtypes.AttributeKeyTxIndex), Value: []byte("0")},
+			}, attrs...),
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		block       *tmtypes.Block
+		blockResult []*abci.ResponseDeliverTx
+		expSuccess  bool
+		expFailed   bool
+	}{
+		{
+			"success, single eth tx",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code:    0,
+					GasUsed: 21000,
+					Events:  []abci.Event{ethTxEvent()},
+				},
+			},
+			true,
+			false,
+		},
+		{
+			"success, eth tx execution failed",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code:    0,
+					GasUsed: 21000,
+					Events: []abci.Event{ethTxEvent(
+						abci.EventAttribute{Key: []byte(evmtypes.AttributeKeyEthereumTxFailed), Value: []byte("execution reverted")},
+					)},
+				},
+			},
+			true,
+			true,
+		},
+		{
+			"fail, tx rejected by the ante handler is not indexed",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code:    11,
+					GasUsed: 21000,
+				},
+			},
+			false,
+			false,
+		},
+		{
+			"fail, invalid tx bytes are skipped",
+			&tmtypes.Block{Header: tmtypes.Header{Height: 1}, Data: tmtypes.Data{Txs: []tmtypes.Tx{[]byte("invalid")}}},
+			[]*abci.ResponseDeliverTx{
+				{
+					Code: 0,
+				},
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := dbm.NewMemDB()
+			idxer := indexer.NewKVIndexer(db, log.NewNopLogger(), clientCtx)
+
+			err := idxer.IndexBlock(tc.block, tc.blockResult)
+			require.NoError(t, err)
+
+			// the block is indexed even if it doesn't contain valid eth txs
+			first, err := idxer.FirstIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, tc.block.Header.Height, first)
+
+			last, err := idxer.LastIndexedBlock()
+			require.NoError(t, err)
+			require.Equal(t, tc.block.Header.Height, last)
+
+			res1, err := idxer.GetByTxHash(txHash)
+			if !tc.expSuccess {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.block.Header.Height, res1.Height)
+			require.Equal(t, uint32(0), res1.TxIndex)
+			require.Equal(t, uint32(0), res1.MsgIndex)
+			require.Equal(t, int32(0), res1.EthTxIndex)
+			require.Equal(t, uint64(21000), res1.GasUsed)
+			require.Equal(t, uint64(21000), res1.CumulativeGasUsed)
+			require.Equal(t, tc.expFailed, res1.Failed)
+
+			res2, err := idxer.GetByBlockAndIndex(tc.block.Header.Height, 0)
+			require.NoError(t, err)
+			require.Equal(t, res1, res2)
+		})
+	}
+}
+
+func TestKVIndexerHeights(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig)
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+
+	first, err := idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), first)
+
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), last)
+
+	// index forward then backward, like the service and the backfill command do
+	for _, height := range []int64{5, 6, 4, 3} {
+		block := &tmtypes.Block{Header: tmtypes.Header{Height: height}}
+		require.NoError(t, idxer.IndexBlock(block, nil))
+	}
+
+	first, err = idxer.FirstIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), first)
+
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(6), last)
+}
+
+func TestKVIndexerLogs(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig)
+	idxer := indexer.NewKVIndexer(dbm.NewMemDB(), log.NewNopLogger(), clientCtx)
+
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr2 := common.BigToAddress(big.NewInt(2))
+	topic1 := common.BigToHash(big.NewInt(1))
+	topic2 := common.BigToHash(big.NewInt(2))
+
+	txLogEvent := func(logs ...*evmtypes.Log) abci.Event {
+		event := abci.Event{Type: evmtypes.EventTypeTxLog}
+		for _, l := range logs {
+			bz, err := json.Marshal(l)
+			require.NoError(t, err)
+			event.Attributes = append(event.Attributes, abci.EventAttribute{Key: []byte(evmtypes.AttributeKeyTxLog), Value: bz})
+		}
+		return event
+	}
+	newLog := func(height int64, index uint64, address common.Address, topics ...common.Hash) *evmtypes.Log {
+		return evmtypes.NewLogFromEth(&ethtypes.Log{
+			Address:     address,
+			Topics:      topics,
+			BlockNumber: uint64(height),
+			Index:       uint(index),
+		})
+	}
+
+	// the logs of block 1, 2 and 3, the logs of the txs rejected by the ante handler are not indexed
+	for height := int64(1); height <= 3; height++ {
+		block := &tmtypes.Block{
+			Header: tmtypes.Header{Height: height},
+			Data:   tmtypes.Data{Txs: []tmtypes.Tx{[]byte("tx1"), []byte("tx2")}},
+		}
+		txResults := []*abci.ResponseDeliverTx{
+			{Code: 0, Events: []abci.Event{txLogEvent(
+				newLog(height, 0, addr1, topic1),
+				newLog(height, 1, addr2, topic1, topic2),
+			)}},
+			{Code: 11, Events: []abci.Event{txLogEvent(newLog(height, 2, addr1))}},
+		}
+		require.NoError(t, idxer.IndexBlock(block, txResults))
+	}
+
+	type position struct {
+		height uint64
+		index  uint
+	}
+
+	testCases := []struct {
+		name         string
+		from, to     int64
+		addresses    []common.Address
+		topics       [][]common.Hash
+		limit        int
+		expPositions []position
+		expErr       bool
+	}{
+		{"all logs", 1, 3, nil, nil, 0, []position{{1, 0}, {1, 1}, {2, 0}, {2, 1}, {3, 0}, {3, 1}}, false},
+		{"sub range", 2, 2, nil, nil, 0, []position{{2, 0}, {2, 1}}, false},
+		{"empty range", 3, 2, nil, nil, 0, []position{}, false},
+		{"by address", 1, 3, []common.Address{addr1}, nil, 0, []position{{1, 0}, {2, 0}, {3, 0}}, false},
+		{"by addresses", 2, 3, []common.Address{addr2, addr1, addr1}, nil, 0, []position{{2, 0}, {2, 1}, {3, 0}, {3, 1}}, false},
+		{"by first topic", 1, 2, nil, [][]common.Hash{{topic1}}, 0, []position{{1, 0}, {1, 1}, {2, 0}, {2, 1}}, false},
+		{"by second topic", 1, 3, nil, [][]common.Hash{nil, {topic2}}, 0, []position{{1, 1}, {2, 1}, {3, 1}}, false},
+		{"by address and topics", 1, 3, []common.Address{addr1}, [][]common.Hash{{topic1}, {topic2}}, 0, []position{}, false},
+		{"topic at the wrong position", 1, 3, nil, [][]common.Hash{{topic2}}, 0, []position{}, false},
+		{"limit reached", 1, 3, nil, nil, 6, []position{{1, 0}, {1, 1}, {2, 0}, {2, 1}, {3, 0}, {3, 1}}, false},
+		{"limit exceeded", 1, 3, []common.Address{addr1}, nil, 2, nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idxer.GetLogs(tc.from, tc.to, tc.addresses, tc.topics, tc.limit)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			positions := make([]position, len(logs))
+			for i, l := range logs {
+				positions[i] = position{l.BlockNumber, l.Index}
+			}
+			require.Equal(t, tc.expPositions, positions)
+		})
+	}
+}
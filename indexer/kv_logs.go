@@ -0,0 +1,253 @@
+package indexer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+const (
+	// KeyPrefixLog is the prefix of the (height, log index) -> log entries
+	KeyPrefixLog = 5
+	// KeyPrefixLogAddress is the prefix of the (address, height, log index) entries
+	KeyPrefixLogAddress = 6
+	// KeyPrefixLogTopic is the prefix of the (topic position, topic, height, log index) entries
+	KeyPrefixLogTopic = 7
+)
+
+// logPosition is the position of a log in the chain.
+type logPosition struct {
+	height int64
+	index  uint64
+}
+
+// GetLogs returns the logs of the blocks in the [fromBlock, toBlock] range matching the addresses and topics
+// criteria, in the chain order. The candidate logs are looked up in the address index if addresses are given,
+// in the index of the first constrained topic position otherwise, and are then matched against the whole
+// criteria. It fails if more than limit logs match, a limit of zero means no limit.
+func (kv *KVIndexer) GetLogs(
+	fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int,
+) ([]*ethtypes.Log, error) {
+	if fromBlock > toBlock {
+		return []*ethtypes.Log{}, nil
+	}
+
+	var prefixes [][]byte
+	switch {
+	case len(addresses) > 0:
+		for _, address := range addresses {
+			prefixes = append(prefixes, append([]byte{KeyPrefixLogAddress}, address.Bytes()...))
+		}
+	default:
+		for position, sub := range topics {
+			if len(sub) == 0 {
+				continue
+			}
+			for _, topic := range sub {
+				prefixes = append(prefixes, append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...))
+			}
+			break
+		}
+	}
+
+	var (
+		positions []logPosition
+		err       error
+	)
+	if len(prefixes) == 0 {
+		// no criteria, all the logs of the range match
+		positions, err = kv.scanLogPositions([]byte{KeyPrefixLog}, fromBlock, toBlock)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, prefix := range prefixes {
+			prefixPositions, err := kv.scanLogPositions(prefix, fromBlock, toBlock)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, prefixPositions...)
+		}
+		sort.Slice(positions, func(i, j int) bool {
+			if positions[i].height != positions[j].height {
+				return positions[i].height < positions[j].height
+			}
+			return positions[i].index < positions[j].index
+		})
+	}
+
+	logs := []*ethtypes.Log{}
+	for i, position := range positions {
+		// the criteria may repeat an address or a topic
+		if i > 0 && positions[i-1] == position {
+			continue
+		}
+
+		log, err := kv.getLog(position)
+		if err != nil {
+			return nil, err
+		}
+		if !matchLog(log, addresses, topics) {
+			continue
+		}
+		if limit > 0 && len(logs) >= limit {
+			return nil, fmt.Errorf("query returned more than %d results", limit)
+		}
+		logs = append(logs, log)
+	}
+	return logs, nil
+}
+
+// scanLogPositions returns the positions of the logs indexed under the prefix in the [fromBlock, toBlock] range.
+func (kv *KVIndexer) scanLogPositions(prefix []byte, fromBlock, toBlock int64) ([]logPosition, error) {
+	start := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(fromBlock))...)
+	end := append(append([]byte{}, prefix...), sdk.Uint64ToBigEndian(uint64(toBlock+1))...)
+
+	it, err := kv.db.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var positions []logPosition
+	for ; it.Valid(); it.Next() {
+		key := it.Key()[len(prefix):]
+		positions = append(positions, logPosition{
+			height: int64(sdk.BigEndianToUint64(key[:8])),
+			index:  sdk.BigEndianToUint64(key[8:]),
+		})
+	}
+	return positions, it.Error()
+}
+
+// getLog loads the log at the given position.
+func (kv *KVIndexer) getLog(position logPosition) (*ethtypes.Log, error) {
+	bz, err := kv.db.Get(LogKey(position.height, position.index))
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("log not found, block: %d, index: %d", position.height, position.index)
+	}
+	var log evmtypes.Log
+	if err := log.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return log.ToEthereum(), nil
+}
+
+// saveBlockLogs indexes the logs emitted by the successful txs of a block into the kv db batch.
+func saveBlockLogs(batch dbm.Batch, height int64, txResults []*abci.ResponseDeliverTx) error {
+	for _, result := range txResults {
+		if result.Code != abci.CodeTypeOK {
+			continue
+		}
+		for _, event := range result.Events {
+			if event.Type != evmtypes.EventTypeTxLog {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if !bytes.Equal(attr.Key, []byte(evmtypes.AttributeKeyTxLog)) {
+					continue
+				}
+
+				var log evmtypes.Log
+				if err := json.Unmarshal(attr.Value, &log); err != nil {
+					return err
+				}
+				if err := saveLog(batch, height, &log); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// saveLog indexes the log by position, address and topics into the kv db batch.
+func saveLog(batch dbm.Batch, height int64, log *evmtypes.Log) error {
+	bz, err := log.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := batch.Set(LogKey(height, log.Index), bz); err != nil {
+		return err
+	}
+	if err := batch.Set(LogAddressKey(common.HexToAddress(log.Address), height, log.Index), []byte{}); err != nil {
+		return err
+	}
+	for position, topic := range log.Topics {
+		if err := batch.Set(LogTopicKey(position, common.HexToHash(topic), height, log.Index), []byte{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchLog returns true if the log matches the addresses and topics criteria, like the eth_getLogs filters.
+func matchLog(log *ethtypes.Log, addresses []common.Address, topics [][]common.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			if log.Address == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			// empty rule set == wildcard
+			continue
+		}
+		found := false
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// LogKey returns the key for db entry: `(block number, log index) -> log`
+func LogKey(blockNumber int64, logIndex uint64) []byte {
+	return append([]byte{KeyPrefixLog}, logPositionBytes(blockNumber, logIndex)...)
+}
+
+// LogAddressKey returns the key for db entry: `(address, block number, log index)`
+func LogAddressKey(address common.Address, blockNumber int64, logIndex uint64) []byte {
+	key := append([]byte{KeyPrefixLogAddress}, address.Bytes()...)
+	return append(key, logPositionBytes(blockNumber, logIndex)...)
+}
+
+// LogTopicKey returns the key for db entry: `(topic position, topic, block number, log index)`
+func LogTopicKey(position int, topic common.Hash, blockNumber int64, logIndex uint64) []byte {
+	key := append([]byte{KeyPrefixLogTopic, byte(position)}, topic.Bytes()...)
+	return append(key, logPositionBytes(blockNumber, logIndex)...)
+}
+
+// logPositionBytes encodes the log position so the keys are sorted in the chain order.
+func logPositionBytes(blockNumber int64, logIndex uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(blockNumber)), sdk.Uint64ToBigEndian(logIndex)...)
+}
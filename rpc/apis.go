@@ -20,6 +20,7 @@ import (
 	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/txpool"
 	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/web3"
 	"github.com/tharsis/ethermint/rpc/ethereum/types"
+	ethermint "github.com/tharsis/ethermint/types"
 
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 )
@@ -38,16 +39,19 @@ const (
 )
 
 // APICreator creates the json-rpc api implementations.
-type APICreator = func(*server.Context, client.Context, *rpcclient.WSClient) []rpc.API
+type APICreator = func(*server.Context, client.Context, *rpcclient.WSClient, ethermint.EVMTxIndexer, *backend.TxQueue, *filters.FilterStore) []rpc.API
 
 // apiCreators defines the json-rpc api namespaces.
 var apiCreators map[string]APICreator
 
 func init() {
 	apiCreators = map[string]APICreator{
-		EthNamespace: func(ctx *server.Context, clientCtx client.Context, tmWSClient *rpcclient.WSClient) []rpc.API {
+		EthNamespace: func(
+			ctx *server.Context, clientCtx client.Context, tmWSClient *rpcclient.WSClient, indexer ethermint.EVMTxIndexer,
+			txQueue *backend.TxQueue, filterStore *filters.FilterStore,
+		) []rpc.API {
 			nonceLock := new(types.AddrLocker)
-			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx)
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer, txQueue)
 			return []rpc.API{
 				{
 					Namespace: EthNamespace,
@@ -58,12 +62,12 @@ func init() {
 				{
 					Namespace: EthNamespace,
 					Version:   apiVersion,
-					Service:   filters.NewPublicAPI(ctx.Logger, clientCtx, tmWSClient, evmBackend),
+					Service:   filters.NewPublicAPI(ctx.Logger, clientCtx, tmWSClient, evmBackend, filterStore),
 					Public:    true,
 				},
 			}
 		},
-		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient) []rpc.API {
+		Web3Namespace: func(*server.Context, client.Context, *rpcclient.WSClient, ethermint.EVMTxIndexer, *backend.TxQueue, *filters.FilterStore) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: Web3Namespace,
@@ -73,7 +77,7 @@ func init() {
 				},
 			}
 		},
-		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient) []rpc.API {
+		NetNamespace: func(_ *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, _ ethermint.EVMTxIndexer, _ *backend.TxQueue, _ *filters.FilterStore) []rpc.API {
 			return []rpc.API{
 				{
 					Namespace: NetNamespace,
@@ -83,8 +87,8 @@ func init() {
 				},
 			}
 		},
-		PersonalNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient) []rpc.API {
-			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx)
+		PersonalNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, indexer ethermint.EVMTxIndexer, txQueue *backend.TxQueue, _ *filters.FilterStore) []rpc.API {
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer, txQueue)
 			return []rpc.API{
 				{
 					Namespace: PersonalNamespace,
@@ -94,18 +98,19 @@ func init() {
 				},
 			}
 		},
-		TxPoolNamespace: func(ctx *server.Context, _ client.Context, _ *rpcclient.WSClient) []rpc.API {
+		TxPoolNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, indexer ethermint.EVMTxIndexer, txQueue *backend.TxQueue, _ *filters.FilterStore) []rpc.API {
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer, txQueue)
 			return []rpc.API{
 				{
 					Namespace: TxPoolNamespace,
 					Version:   apiVersion,
-					Service:   txpool.NewPublicAPI(ctx.Logger),
+					Service:   txpool.NewPublicAPI(ctx.Logger, evmBackend),
 					Public:    true,
 				},
 			}
 		},
-		DebugNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient) []rpc.API {
-			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx)
+		DebugNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, indexer ethermint.EVMTxIndexer, txQueue *backend.TxQueue, _ *filters.FilterStore) []rpc.API {
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer, txQueue)
 			return []rpc.API{
 				{
 					Namespace: DebugNamespace,
@@ -115,8 +120,8 @@ func init() {
 				},
 			}
 		},
-		MinerNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient) []rpc.API {
-			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx)
+		MinerNamespace: func(ctx *server.Context, clientCtx client.Context, _ *rpcclient.WSClient, indexer ethermint.EVMTxIndexer, txQueue *backend.TxQueue, _ *filters.FilterStore) []rpc.API {
+			evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer, txQueue)
 			return []rpc.API{
 				{
 					Namespace: MinerNamespace,
@@ -130,12 +135,20 @@ func init() {
 }
 
 // GetRPCAPIs returns the list of all APIs
-func GetRPCAPIs(ctx *server.Context, clientCtx client.Context, tmWSClient *rpcclient.WSClient, selectedAPIs []string) []rpc.API {
+func GetRPCAPIs(
+	ctx *server.Context,
+	clientCtx client.Context,
+	tmWSClient *rpcclient.WSClient,
+	indexer ethermint.EVMTxIndexer,
+	txQueue *backend.TxQueue,
+	filterStore *filters.FilterStore,
+	selectedAPIs []string,
+) []rpc.API {
 	var apis []rpc.API
 
 	for _, ns := range selectedAPIs {
 		if creator, ok := apiCreators[ns]; ok {
-			apis = append(apis, creator(ctx, clientCtx, tmWSClient)...)
+			apis = append(apis, creator(ctx, clientCtx, tmWSClient, indexer, txQueue, filterStore)...)
 		} else {
 			ctx.Logger.Error("invalid namespace value", "namespace", ns)
 		}
@@ -11,10 +11,11 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
+	lru "github.com/hashicorp/golang-lru"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	"google.golang.org/grpc"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -50,6 +52,8 @@ type Backend interface {
 	RPCTxFeeCap() float64         // RPCTxFeeCap is the global transaction fee(price * gaslimit) cap for send-transaction variants. The unit is ether.
 
 	RPCMinGasPrice() int64
+	RPCTraceBlockChunkSize() int32 // max number of eth txs traced by a single trace block query
+	RPCTraceBlockReplayCap() int32 // max number of eth txs replayed by the chunks of a block trace
 	SuggestGasTipCap(baseFee *big.Int) (*big.Int, error)
 
 	// Blockchain API
@@ -64,12 +68,17 @@ type Backend interface {
 	HeaderByNumber(blockNum types.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 	PendingTransactions() ([]*sdk.Tx, error)
+	NumPendingTransactions() (int, error)
+	PendingEthereumTxsFrom(senders ...common.Address) ([]*evmtypes.MsgEthereumTx, error)
+	QueuedEthereumTxs(senders ...common.Address) []*evmtypes.MsgEthereumTx
+	BroadcastEthereumTx(msg *evmtypes.MsgEthereumTx, txBytes []byte) error
 	GetTransactionCount(address common.Address, blockNum types.BlockNumber) (*hexutil.Uint64, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	GetCoinbase() (sdk.AccAddress, error)
 	GetTransactionByHash(txHash common.Hash) (*types.RPCTransaction, error)
-	GetTxByEthHash(txHash common.Hash) (*tmrpctypes.ResultTx, error)
-	GetTxByTxIndex(height int64, txIndex uint) (*tmrpctypes.ResultTx, error)
+	GetTxByEthHash(txHash common.Hash) (*ethermint.TxResult, error)
+	GetTxByTxIndex(height int64, txIndex uint) (*ethermint.TxResult, error)
+	GetEthereumMsgFromTxResult(block *tmrpctypes.ResultBlock, res *ethermint.TxResult) (*evmtypes.MsgEthereumTx, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *types.BlockNumber) (hexutil.Uint64, error)
 	BaseFee(height int64) (*big.Int, error)
 
@@ -77,6 +86,7 @@ type Backend interface {
 	BloomStatus() (uint64, uint64)
 	GetLogs(hash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error)
+	GetIndexedLogs(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error)
 	ChainConfig() *params.ChainConfig
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	GetEthereumMsgsFromTendermintBlock(block *tmrpctypes.ResultBlock, blockRes *tmrpctypes.ResultBlockResults) []*evmtypes.MsgEthereumTx
@@ -84,6 +94,14 @@ type Backend interface {
 
 var _ Backend = (*EVMBackend)(nil)
 
+// blockLogsCacheSize is the number of blocks whose logs are cached by hash
+const blockLogsCacheSize = 128
+
+// MaxPendingTxs is the maximum number of transactions read from the transaction pool, it's also the
+// maximum number of transactions returned by the Tendermint unconfirmed_txs endpoint unless the
+// Tendermint rpc is unsafe.
+const MaxPendingTxs = 100
+
 var bAttributeKeyEthereumBloom = []byte(evmtypes.AttributeKeyEthereumBloom)
 
 // EVMBackend implements the Backend interface
@@ -94,10 +112,16 @@ type EVMBackend struct {
 	logger      log.Logger
 	chainID     *big.Int
 	cfg         config.Config
+	indexer     ethermint.EVMTxIndexer
+	txQueue     *TxQueue
+	// blockLogs caches the logs of the blocks by hash, they can't change since the blocks are final.
+	blockLogs *lru.Cache
 }
 
 // NewEVMBackend creates a new EVMBackend instance
-func NewEVMBackend(ctx *server.Context, logger log.Logger, clientCtx client.Context) *EVMBackend {
+func NewEVMBackend(
+	ctx *server.Context, logger log.Logger, clientCtx client.Context, indexer ethermint.EVMTxIndexer, txQueue *TxQueue,
+) *EVMBackend {
 	chainID, err := ethermint.ParseChainID(clientCtx.ChainID)
 	if err != nil {
 		panic(err)
@@ -108,6 +132,11 @@ func NewEVMBackend(ctx *server.Context, logger log.Logger, clientCtx client.Cont
 		panic(err)
 	}
 
+	blockLogs, err := lru.New(blockLogsCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
 	return &EVMBackend{
 		ctx:         context.Background(),
 		clientCtx:   clientCtx,
@@ -115,6 +144,9 @@ func NewEVMBackend(ctx *server.Context, logger log.Logger, clientCtx client.Cont
 		logger:      logger.With("module", "evm-backend"),
 		chainID:     chainID,
 		cfg:         appConf,
+		indexer:     indexer,
+		txQueue:     txQueue,
+		blockLogs:   blockLogs,
 	}
 }
 
@@ -538,10 +570,11 @@ func (e *EVMBackend) HeaderByHash(blockHash common.Hash) (*ethtypes.Header, erro
 	return ethHeader, nil
 }
 
-// PendingTransactions returns the transactions that are in the transaction pool
-// and have a from address that is one of the accounts this node manages.
+// PendingTransactions returns the first MaxPendingTxs transactions of the transaction
+// pool, in the order of the transaction pool.
 func (e *EVMBackend) PendingTransactions() ([]*sdk.Tx, error) {
-	res, err := e.clientCtx.Client.UnconfirmedTxs(e.ctx, nil)
+	limit := MaxPendingTxs
+	res, err := e.clientCtx.Client.UnconfirmedTxs(e.ctx, &limit)
 	if err != nil {
 		return nil, err
 	}
@@ -558,6 +591,263 @@ func (e *EVMBackend) PendingTransactions() ([]*sdk.Tx, error) {
 	return result, nil
 }
 
+// NumPendingTransactions returns the number of transactions in the transaction pool,
+// the cosmos txs included.
+func (e *EVMBackend) NumPendingTransactions() (int, error) {
+	res, err := e.clientCtx.Client.NumUnconfirmedTxs(e.ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res.Total, nil
+}
+
+// PendingEthereumTxsFrom returns the eth txs in the transaction pool sent by one
+// of the given addresses, in the order of the transaction pool.
+func (e *EVMBackend) PendingEthereumTxsFrom(senders ...common.Address) ([]*evmtypes.MsgEthereumTx, error) {
+	txs, err := e.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*evmtypes.MsgEthereumTx
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+
+			from, err := ethMsg.GetSender(e.chainID)
+			if err != nil {
+				e.logger.Debug("failed to get the sender of pending tx", "hash", ethMsg.Hash, "error", err.Error())
+				continue
+			}
+
+			for _, sender := range senders {
+				if from == sender {
+					result = append(result, ethMsg)
+					break
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// QueuedEthereumTxs returns the eth txs held by the tx queue until the nonce gap of their sender is filled, for
+// the given senders or all the senders if none is given.
+func (e *EVMBackend) QueuedEthereumTxs(senders ...common.Address) []*evmtypes.MsgEthereumTx {
+	if !e.txQueue.Enabled() {
+		return nil
+	}
+	return e.txQueue.Txs(senders...)
+}
+
+// BroadcastEthereumTx broadcasts the encoded cosmos tx of an eth tx in sync mode. If the tx is rejected because
+// its nonce is ahead of the next nonce of the sender, it's held by the tx queue and broadcasted again once the
+// nonce gap is filled. If the nonce is used by a queued tx of the sender, or by a pending tx broadcasted by this
+// node, the tx replaces it when its effective tip is higher by the configured price bump. The queued txs of the
+// sender following an accepted tx are broadcasted after it. The queueing and the replacements are disabled along
+// with the tx queue.
+func (e *EVMBackend) BroadcastEthereumTx(msg *evmtypes.MsgEthereumTx, txBytes []byte) error {
+	var from common.Address
+	if e.txQueue.Enabled() {
+		var err error
+		if from, err = msg.GetSender(e.chainID); err != nil {
+			return err
+		}
+
+		// a queued tx is replaced in the queue
+		check := func(queued *evmtypes.MsgEthereumTx) error { return e.checkReplacement(queued, msg) }
+		if queued, err := e.txQueue.Replace(from, msg.AsTransaction().Nonce(), msg, txBytes, check); queued {
+			return err
+		}
+	}
+
+	err := e.broadcastTx(txBytes)
+	if err == nil {
+		if e.txQueue.Enabled() {
+			e.markSent(from, msg, txBytes)
+			e.PromoteQueuedTxs(from)
+		}
+		return nil
+	}
+
+	if !e.txQueue.Enabled() || !sdkerrors.ErrInvalidSequence.Is(err) {
+		return err
+	}
+
+	nonce, nonceErr := e.getAccountNonce(from, true, 0, e.logger)
+	if nonceErr != nil {
+		return err
+	}
+
+	switch txNonce := msg.AsTransaction().Nonce(); {
+	case txNonce > nonce:
+		if err := e.txQueue.Add(from, msg, txBytes); err != nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidSequence, err.Error())
+		}
+		e.logger.Debug("eth tx queued until the nonce gap is filled", "hash", msg.Hash, "nonce", txNonce, "expected", nonce)
+		return nil
+	case txNonce < nonce:
+		return e.replacePendingTx(from, msg, txBytes, err)
+	default:
+		return err
+	}
+}
+
+// replacePendingTx replaces the pending tx broadcasted by this node for the sender with the same nonce as the
+// given tx, if the given tx bumps its price enough, otherwise it returns the broadcast error. The pending txs
+// broadcasted by other nodes can't be replaced. The CheckTx state keeps the nonce of the replaced tx used until
+// the next block, so the replaced tx and the following txs of the sender are removed from the mempool, and the
+// new tx is queued along with the following txs to be broadcasted again after the next block. The txs are only
+// removed from the local mempool, the peers which already received the replaced tx may still include it in a
+// block, the replacement is then dropped.
+func (e *EVMBackend) replacePendingTx(from common.Address, msg *evmtypes.MsgEthereumTx, txBytes []byte, broadcastErr error) error {
+	nonce := msg.AsTransaction().Nonce()
+	sent := e.txQueue.sentFrom(from, nonce)
+
+	// the nonce is already executed, or the tx was not broadcasted by this node
+	if len(sent) == 0 || sent[0].msg.AsTransaction().Nonce() != nonce {
+		return broadcastErr
+	}
+	replaced := sent[0].msg
+	if err := e.checkReplacement(replaced, msg); err != nil {
+		return err
+	}
+
+	queued := append([]queuedTx{{msg: msg, txBytes: txBytes}}, sent[1:]...)
+	for i, tx := range queued {
+		if err := e.txQueue.Add(from, tx.msg, tx.txBytes); err != nil {
+			// restore the queue, the mempool is untouched
+			for _, added := range queued[:i] {
+				e.txQueue.Pop(from, added.msg.AsTransaction().Nonce())
+			}
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidSequence, "failed to queue replacement tx: %s", err.Error())
+		}
+	}
+
+	// the txs are removed from the highest nonce, so if a removal fails, the txs still in the mempool have no gap
+	// and only the queued txs following them are kept in the queue
+	for i := len(sent) - 1; i >= 0; i-- {
+		sentNonce := sent[i].msg.AsTransaction().Nonce()
+		if err := e.clientCtx.Client.RemoveTx(e.ctx, tmtypes.Tx(sent[i].txBytes).Key()); err != nil {
+			for _, tx := range queued[:i+1] {
+				e.txQueue.Pop(from, tx.msg.AsTransaction().Nonce())
+			}
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidSequence, "failed to remove tx %s from the mempool: %s", sent[i].msg.Hash, err.Error(),
+			)
+		}
+		e.txQueue.RemoveSent(from, sentNonce)
+	}
+
+	e.logger.Debug("eth tx replaced", "hash", replaced.Hash, "replacement", msg.Hash, "nonce", nonce)
+	return nil
+}
+
+// checkReplacement returns an error if the new tx doesn't bump the price of the tx it replaces enough.
+func (e *EVMBackend) checkReplacement(oldMsg, newMsg *evmtypes.MsgEthereumTx) error {
+	bn, err := e.BlockNumber()
+	if err != nil {
+		return err
+	}
+	baseFee, err := e.BaseFee(int64(bn))
+	if err != nil {
+		return err
+	}
+
+	if !IsPriceBumped(oldMsg.AsTransaction(), newMsg.AsTransaction(), baseFee, e.cfg.JSONRPC.PriceBump) {
+		return sdkerrors.Wrapf(
+			core.ErrReplaceUnderpriced, "effective tip must be %d%% higher than the one of tx %s", e.cfg.JSONRPC.PriceBump, oldMsg.Hash,
+		)
+	}
+	return nil
+}
+
+// PromoteQueuedTxs broadcasts the queued txs of the given senders, or of all the senders if none is given, which
+// follow the next nonce of their sender without gap. The queued txs which can't be executed anymore are dropped.
+func (e *EVMBackend) PromoteQueuedTxs(senders ...common.Address) {
+	if !e.txQueue.Enabled() {
+		return
+	}
+	if len(senders) == 0 {
+		senders = e.txQueue.Senders()
+	}
+
+	for _, sender := range senders {
+		nonce, err := e.getAccountNonce(sender, true, 0, e.logger)
+		if err != nil {
+			e.logger.Debug("failed to get the nonce of queued txs sender", "sender", sender.Hex(), "error", err.Error())
+			continue
+		}
+		e.txQueue.Prune(sender, nonce)
+
+		for {
+			msg, txBytes, ok := e.txQueue.Pop(sender, nonce)
+			if !ok {
+				break
+			}
+			if err := e.broadcastTx(txBytes); err != nil {
+				e.logger.Debug("failed to broadcast queued tx", "sender", sender.Hex(), "nonce", nonce, "error", err.Error())
+				// the nonce is still used by the CheckTx state until the next block, for example after a replacement
+				if sdkerrors.ErrInvalidSequence.Is(err) {
+					if err := e.txQueue.Add(sender, msg, txBytes); err != nil {
+						e.logger.Debug("failed to queue tx again", "hash", msg.Hash, "error", err.Error())
+					}
+				}
+				break
+			}
+			e.markSent(sender, msg, txBytes)
+			nonce++
+		}
+	}
+}
+
+// markSent tracks the eth tx broadcasted by this node so it can be replaced.
+func (e *EVMBackend) markSent(sender common.Address, msg *evmtypes.MsgEthereumTx, txBytes []byte) {
+	if !e.txQueue.MarkSent(sender, msg, txBytes) {
+		e.logger.Debug("sent txs cap reached, the tx can't be replaced", "hash", msg.Hash)
+	}
+}
+
+// ProcessTxQueue promotes the queued txs every time the block height increases, the nonce gaps may be filled by
+// txs received by other nodes. It blocks until the context is done.
+func (e *EVMBackend) ProcessTxQueue(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastHeight hexutil.Uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if len(e.txQueue.Senders()) == 0 {
+				continue
+			}
+			height, err := e.BlockNumber()
+			if err != nil || height == lastHeight {
+				continue
+			}
+			lastHeight = height
+			e.PromoteQueuedTxs()
+		}
+	}
+}
+
+// broadcastTx broadcasts the encoded tx in sync mode, the CheckTx failures are returned as errors.
+func (e *EVMBackend) broadcastTx(txBytes []byte) error {
+	syncCtx := e.clientCtx.WithBroadcastMode(flags.BroadcastSync)
+	rsp, err := syncCtx.BroadcastTx(txBytes)
+	if rsp != nil && rsp.Code != 0 {
+		err = types.TxResponseError(rsp)
+	}
+	return err
+}
+
 // GetLogsByHeight returns all the logs from all the ethereum transactions in a block.
 func (e *EVMBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	// NOTE: we query the state in case the tx result logs are not persisted after an upgrade.
@@ -579,13 +869,27 @@ func (e *EVMBackend) GetLogsByHeight(height *int64) ([][]*ethtypes.Log, error) {
 	return blockLogs, nil
 }
 
-// GetLogs returns all the logs from all the ethereum transactions in a block.
+// GetLogs returns all the logs from all the ethereum transactions in a block, it fails if the block is unknown.
+// The logs are cached by block hash, the callers must not modify them.
 func (e *EVMBackend) GetLogs(hash common.Hash) ([][]*ethtypes.Log, error) {
+	if logs, ok := e.blockLogs.Get(hash); ok {
+		return logs.([][]*ethtypes.Log), nil
+	}
+
 	block, err := e.clientCtx.Client.BlockByHash(e.ctx, hash.Bytes())
 	if err != nil {
 		return nil, err
 	}
-	return e.GetLogsByHeight(&block.Block.Header.Height)
+	if block == nil || block.Block == nil {
+		return nil, errors.Errorf("unknown block %s", hash.Hex())
+	}
+
+	logs, err := e.GetLogsByHeight(&block.Block.Header.Height)
+	if err != nil {
+		return nil, err
+	}
+	e.blockLogs.Add(hash, logs)
+	return logs, nil
 }
 
 func (e *EVMBackend) GetLogsByNumber(blockNum types.BlockNumber) ([][]*ethtypes.Log, error) {
@@ -613,6 +917,35 @@ func (e *EVMBackend) GetLogsByNumber(blockNum types.BlockNumber) ([][]*ethtypes.
 	return e.GetLogsByHeight(&height)
 }
 
+// GetIndexedLogs returns the logs of the blocks in the [fromBlock, toBlock] range matching the addresses and
+// topics criteria from the custom indexer log index. It returns false if the indexer is disabled or if the range
+// is not fully indexed, the logs must then be collected from the blocks.
+func (e *EVMBackend) GetIndexedLogs(
+	fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int,
+) ([]*ethtypes.Log, bool, error) {
+	if e.indexer == nil {
+		return nil, false, nil
+	}
+
+	first, err := e.indexer.FirstIndexedBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	last, err := e.indexer.LastIndexedBlock()
+	if err != nil {
+		return nil, false, err
+	}
+	if first == -1 || fromBlock < first || toBlock > last {
+		return nil, false, nil
+	}
+
+	logs, err := e.indexer.GetLogs(fromBlock, toBlock, addresses, topics, limit)
+	if err != nil {
+		return nil, false, err
+	}
+	return logs, true, nil
+}
+
 // BloomStatus returns the BloomBitsBlocks and the number of processed sections maintained
 // by the chain indexer.
 func (e *EVMBackend) BloomStatus() (uint64, uint64) {
@@ -682,94 +1015,174 @@ func (e *EVMBackend) GetTransactionByHash(txHash common.Hash) (*types.RPCTransac
 		return nil, nil
 	}
 
-	if res.TxResult.Code != 0 {
-		return nil, errors.New("invalid ethereum tx")
-	}
-
-	msgIndex, attrs := types.FindTxAttributes(res.TxResult.Events, hexTx)
-	if msgIndex < 0 {
-		return nil, fmt.Errorf("ethereum tx not found in msgs: %s", hexTx)
-	}
-
-	tx, err := e.clientCtx.TxConfig.TxDecoder()(res.Tx)
-	if err != nil {
-		return nil, err
-	}
-
-	// the `msgIndex` is inferred from tx events, should be within the bound.
-	msg, ok := tx.GetMsgs()[msgIndex].(*evmtypes.MsgEthereumTx)
-	if !ok {
-		return nil, errors.New("invalid ethereum tx")
-	}
-
 	block, err := e.clientCtx.Client.Block(e.ctx, &res.Height)
 	if err != nil {
 		e.logger.Debug("block not found", "height", res.Height, "error", err.Error())
 		return nil, err
 	}
 
-	// Try to find txIndex from events
-	found := false
-	txIndex, err := types.GetUint64Attribute(attrs, evmtypes.AttributeKeyTxIndex)
-	if err == nil {
-		found = true
-	} else {
-		// Fallback to find tx index by iterating all valid eth transactions
-		blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &block.Block.Height)
-		if err != nil {
-			return nil, nil
-		}
-		msgs := e.GetEthereumMsgsFromTendermintBlock(block, blockRes)
-		for i := range msgs {
-			if msgs[i].Hash == hexTx {
-				txIndex = uint64(i)
-				found = true
-				break
-			}
-		}
-	}
-	if !found {
-		return nil, errors.New("can't find index of ethereum tx")
+	msg, err := e.GetEthereumMsgFromTxResult(block, res)
+	if err != nil {
+		return nil, err
 	}
 
 	return types.NewTransactionFromMsg(
 		msg,
 		common.BytesToHash(block.BlockID.Hash.Bytes()),
 		uint64(res.Height),
-		txIndex,
+		uint64(res.EthTxIndex),
 		e.chainID,
 	)
 }
 
-// GetTxByEthHash uses `/tx_query` to find transaction by ethereum tx hash
-// TODO: Don't need to convert once hashing is fixed on Tendermint
-// https://github.com/tendermint/tendermint/issues/6539
-func (e *EVMBackend) GetTxByEthHash(hash common.Hash) (*tmrpctypes.ResultTx, error) {
-	query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())
-	resTxs, err := e.clientCtx.Client.TxSearch(e.ctx, query, false, nil, nil, "")
+// GetEthereumMsgFromTxResult returns the ethereum msg located by the indexed tx result in the block.
+func (e *EVMBackend) GetEthereumMsgFromTxResult(block *tmrpctypes.ResultBlock, res *ethermint.TxResult) (*evmtypes.MsgEthereumTx, error) {
+	if int(res.TxIndex) >= len(block.Block.Txs) {
+		return nil, fmt.Errorf("tx index %d out of bound in block %d", res.TxIndex, block.Block.Height)
+	}
+
+	tx, err := e.clientCtx.TxConfig.TxDecoder()(block.Block.Txs[res.TxIndex])
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
 	}
-	if len(resTxs.Txs) == 0 {
-		return nil, errors.Errorf("ethereum tx not found for hash %s", hash.Hex())
+
+	msgs := tx.GetMsgs()
+	if int(res.MsgIndex) >= len(msgs) {
+		return nil, fmt.Errorf("msg index %d out of bound in tx %d of block %d", res.MsgIndex, res.TxIndex, block.Block.Height)
 	}
-	return resTxs.Txs[0], nil
+
+	msg, ok := msgs[res.MsgIndex].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return nil, fmt.Errorf("invalid tx type: %T", msgs[res.MsgIndex])
+	}
+
+	return msg, nil
 }
 
-// GetTxByTxIndex uses `/tx_query` to find transaction by tx index of valid ethereum txs
-func (e *EVMBackend) GetTxByTxIndex(height int64, index uint) (*tmrpctypes.ResultTx, error) {
+// GetTxByEthHash finds the ethereum tx by its hash, using the custom tx indexer
+// if enabled, `/tx_search` otherwise or if the custom indexer doesn't have it, the
+// custom indexer misses the blocks before it was enabled and the ones it lags on.
+// TODO: Don't need to convert once hashing is fixed on Tendermint
+// https://github.com/tendermint/tendermint/issues/6539
+func (e *EVMBackend) GetTxByEthHash(hash common.Hash) (*ethermint.TxResult, error) {
+	if e.indexer != nil {
+		txResult, err := e.indexer.GetByTxHash(hash)
+		if err == nil {
+			return txResult, nil
+		}
+		e.logger.Debug("tx not found in the custom indexer", "hash", hash.Hex(), "error", err.Error())
+	}
+
+	// fallback to tendermint tx indexer
+	query := fmt.Sprintf("%s.%s='%s'", evmtypes.TypeMsgEthereumTx, evmtypes.AttributeKeyEthereumTxHash, hash.Hex())
+	return e.queryTendermintTxIndexer(query, func(txs []types.ParsedTx) *types.ParsedTx {
+		return types.FindParsedTx(txs, hash)
+	})
+}
+
+// GetTxByTxIndex finds the ethereum tx by its index among the valid ethereum txs
+// of the block, using the custom tx indexer if enabled and the block is in its
+// indexed range, `/tx_search` otherwise or if the custom indexer doesn't have it.
+func (e *EVMBackend) GetTxByTxIndex(height int64, index uint) (*ethermint.TxResult, error) {
+	if e.indexer != nil && e.isIndexedBlock(height) {
+		txResult, err := e.indexer.GetByBlockAndIndex(height, int32(index))
+		if err == nil {
+			return txResult, nil
+		}
+		e.logger.Debug("tx not found in the custom indexer", "height", height, "index", index, "error", err.Error())
+	}
+
+	// fallback to tendermint tx indexer
 	query := fmt.Sprintf("tx.height=%d AND %s.%s=%d",
 		height, evmtypes.TypeMsgEthereumTx,
 		evmtypes.AttributeKeyTxIndex, index,
 	)
+	return e.queryTendermintTxIndexer(query, func(txs []types.ParsedTx) *types.ParsedTx {
+		return types.FindParsedTxByEthTxIndex(txs, int32(index))
+	})
+}
+
+// isIndexedBlock returns true if the block height is in the range indexed by the custom tx indexer.
+func (e *EVMBackend) isIndexedBlock(height int64) bool {
+	first, err := e.indexer.FirstIndexedBlock()
+	if err != nil || first == -1 || height < first {
+		return false
+	}
+	last, err := e.indexer.LastIndexedBlock()
+	if err != nil || height > last {
+		return false
+	}
+	return true
+}
+
+// queryTendermintTxIndexer finds the ethereum tx with `/tx_search`, and builds
+// the same result as the custom tx indexer from the tx events and the block results.
+func (e *EVMBackend) queryTendermintTxIndexer(query string, txGetter func([]types.ParsedTx) *types.ParsedTx) (*ethermint.TxResult, error) {
 	resTxs, err := e.clientCtx.Client.TxSearch(e.ctx, query, false, nil, nil, "")
 	if err != nil {
 		return nil, err
 	}
 	if len(resTxs.Txs) == 0 {
-		return nil, errors.Errorf("ethereum tx not found for block %d index %d", height, index)
+		return nil, errors.Errorf("ethereum tx not found for query %s", query)
+	}
+
+	res := resTxs.Txs[0]
+	if res.TxResult.Code != 0 {
+		return nil, errors.New("invalid ethereum tx")
+	}
+
+	txs, err := types.ParseTxResult(&res.TxResult)
+	if err != nil {
+		return nil, err
 	}
-	return resTxs.Txs[0], nil
+
+	parsedTx := txGetter(txs)
+	if parsedTx == nil {
+		return nil, errors.Errorf("ethereum tx not found in msgs for query %s", query)
+	}
+
+	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the txs of the block before this one, and the msgs of the batch up to this one
+	var cumulativeGasUsed uint64
+	for i := 0; i < int(res.Index) && i < len(blockRes.TxsResults); i++ {
+		cumulativeGasUsed += uint64(blockRes.TxsResults[i].GasUsed)
+	}
+	for _, tx := range txs[:parsedTx.MsgIndex+1] {
+		cumulativeGasUsed += tx.GasUsed
+	}
+
+	ethTxIndex := parsedTx.EthTxIndex
+	if ethTxIndex == -1 {
+		// Fallback to find tx index by iterating all valid eth transactions
+		block, err := e.clientCtx.Client.Block(e.ctx, &res.Height)
+		if err != nil {
+			return nil, err
+		}
+		msgs := e.GetEthereumMsgsFromTendermintBlock(block, blockRes)
+		for i := range msgs {
+			if msgs[i].Hash == parsedTx.Hash.Hex() {
+				ethTxIndex = int32(i)
+				break
+			}
+		}
+		if ethTxIndex == -1 {
+			return nil, errors.New("can't find index of ethereum tx")
+		}
+	}
+
+	return &ethermint.TxResult{
+		Height:            res.Height,
+		TxIndex:           res.Index,
+		MsgIndex:          uint32(parsedTx.MsgIndex),
+		EthTxIndex:        ethTxIndex,
+		Failed:            parsedTx.Failed,
+		GasUsed:           parsedTx.GasUsed,
+		CumulativeGasUsed: cumulativeGasUsed,
+	}, nil
 }
 
 func (e *EVMBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
@@ -830,13 +1243,7 @@ func (e *EVMBackend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash
 	txHash := msg.AsTransaction().Hash()
 
 	// Broadcast transaction in sync mode (default)
-	// NOTE: If error is encountered on the node, the broadcast will not return an error
-	syncCtx := e.clientCtx.WithBroadcastMode(flags.BroadcastSync)
-	rsp, err := syncCtx.BroadcastTx(txBytes)
-	if rsp != nil && rsp.Code != 0 {
-		err = sdkerrors.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
-	}
-	if err != nil {
+	if err := e.BroadcastEthereumTx(msg, txBytes); err != nil {
 		e.logger.Error("failed to broadcast tx", "error", err.Error())
 		return txHash, err
 	}
@@ -865,10 +1272,14 @@ func (e *EVMBackend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	res, err := e.queryClient.EstimateGas(types.ContextWithHeight(blockNr.Int64()), &req)
+	ctx := types.ContextWithHeight(blockNr.Int64())
+	res, err := e.queryClient.EstimateGas(ctx, &req)
 	if err != nil {
 		return 0, err
 	}
+	if res.Failed() {
+		return 0, evmtypes.NewExecErrorWithReason(res.Revert())
+	}
 	return hexutil.Uint64(res.Gas), nil
 }
 
@@ -910,6 +1321,16 @@ func (e *EVMBackend) RPCTxFeeCap() float64 {
 	return e.cfg.JSONRPC.TxFeeCap
 }
 
+// RPCTraceBlockChunkSize is the max number of eth txs traced by a single trace block query.
+func (e *EVMBackend) RPCTraceBlockChunkSize() int32 {
+	return e.cfg.JSONRPC.TraceBlockChunkSize
+}
+
+// RPCTraceBlockReplayCap is the max number of eth txs replayed in total by the chunks of a block trace.
+func (e *EVMBackend) RPCTraceBlockReplayCap() int32 {
+	return e.cfg.JSONRPC.TraceBlockReplayCap
+}
+
 // RPCFilterCap is the limit for total number of filters that can be created
 func (e *EVMBackend) RPCFilterCap() int32 {
 	return e.cfg.JSONRPC.FilterCap
@@ -0,0 +1,68 @@
+package backend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/bytes"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// blockClient serves a single block and counts the block queries.
+type blockClient struct {
+	tmrpcclient.Client
+
+	block   *tmtypes.Block
+	queries int
+}
+
+func (c *blockClient) BlockByHash(_ context.Context, hash bytes.HexBytes) (*tmrpctypes.ResultBlock, error) {
+	c.queries++
+	if !c.block.HashesTo(hash) {
+		return &tmrpctypes.ResultBlock{}, nil
+	}
+	return &tmrpctypes.ResultBlock{Block: c.block}, nil
+}
+
+func (c *blockClient) BlockResults(_ context.Context, height *int64) (*tmrpctypes.ResultBlockResults, error) {
+	c.queries++
+	return &tmrpctypes.ResultBlockResults{Height: *height}, nil
+}
+
+func TestGetLogsCache(t *testing.T) {
+	tmClient := &blockClient{block: tmtypes.MakeBlock(10, nil, &tmtypes.Commit{}, nil)}
+	blockLogs, err := lru.New(blockLogsCacheSize)
+	require.NoError(t, err)
+
+	backend := &EVMBackend{
+		ctx:       context.Background(),
+		clientCtx: client.Context{}.WithClient(tmClient),
+		blockLogs: blockLogs,
+	}
+
+	// the unknown blocks fail and aren't cached
+	unknown := common.BytesToHash([]byte{1})
+	_, err = backend.GetLogs(unknown)
+	require.Error(t, err)
+	_, err = backend.GetLogs(unknown)
+	require.Error(t, err)
+	require.Equal(t, 2, tmClient.queries)
+
+	// the logs of a known block are fetched once
+	hash := common.BytesToHash(tmClient.block.Hash())
+	logs, err := backend.GetLogs(hash)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+	require.Equal(t, 4, tmClient.queries)
+
+	logs, err = backend.GetLogs(hash)
+	require.NoError(t, err)
+	require.Empty(t, logs)
+	require.Equal(t, 4, tmClient.queries)
+}
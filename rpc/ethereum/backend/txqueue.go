@@ -0,0 +1,268 @@
+package backend
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// queuedTx is an eth tx held by the TxQueue along with its encoded cosmos tx.
+type queuedTx struct {
+	msg     *evmtypes.MsgEthereumTx
+	txBytes []byte
+}
+
+// TxQueue holds the eth txs rejected by CheckTx because their nonce is ahead of the next nonce of their sender,
+// until the nonce gap is filled and they can be broadcasted again. The queue is bounded per sender and globally,
+// it is local to the node and shared by all the JSON-RPC namespaces. It also tracks the eth txs broadcasted by
+// the node which are not executed yet, so they can be replaced, up to the global cap.
+type TxQueue struct {
+	mtx       sync.Mutex
+	senderCap int
+	cap       int
+	size      int
+	txs       map[common.Address]map[uint64]queuedTx
+	sentSize  int
+	sent      map[common.Address]map[uint64]queuedTx
+}
+
+// NewTxQueue creates the TxQueue, the queue is disabled if any of the caps is zero.
+func NewTxQueue(senderCap, cap int) *TxQueue {
+	return &TxQueue{
+		senderCap: senderCap,
+		cap:       cap,
+		txs:       make(map[common.Address]map[uint64]queuedTx),
+		sent:      make(map[common.Address]map[uint64]queuedTx),
+	}
+}
+
+// Enabled returns true if the txs can be queued.
+func (q *TxQueue) Enabled() bool {
+	return q != nil && q.senderCap > 0 && q.cap > 0
+}
+
+// Add queues the eth tx of the sender, it replaces the queued tx with the same nonce if any.
+func (q *TxQueue) Add(sender common.Address, msg *evmtypes.MsgEthereumTx, txBytes []byte) error {
+	if !q.Enabled() {
+		return fmt.Errorf("tx queue is disabled")
+	}
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	nonce := msg.AsTransaction().Nonce()
+	senderTxs, ok := q.txs[sender]
+	if !ok {
+		senderTxs = make(map[uint64]queuedTx)
+		q.txs[sender] = senderTxs
+	}
+
+	if _, ok := senderTxs[nonce]; !ok {
+		if len(senderTxs) >= q.senderCap {
+			return fmt.Errorf("tx queue is full for sender %s, cap: %d", sender.Hex(), q.senderCap)
+		}
+		if q.size >= q.cap {
+			return fmt.Errorf("tx queue is full, cap: %d", q.cap)
+		}
+		q.size++
+	}
+
+	senderTxs[nonce] = queuedTx{msg: msg, txBytes: txBytes}
+	return nil
+}
+
+// Get returns the eth tx queued for the sender and nonce.
+func (q *TxQueue) Get(sender common.Address, nonce uint64) (*evmtypes.MsgEthereumTx, bool) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	tx, ok := q.txs[sender][nonce]
+	return tx.msg, ok
+}
+
+// Replace replaces the eth tx queued for the sender and nonce if check accepts the queued tx, the lookup, the check
+// and the swap are atomic so the queued tx can't be popped in between. It returns false if no tx is queued for the
+// nonce.
+func (q *TxQueue) Replace(
+	sender common.Address, nonce uint64, msg *evmtypes.MsgEthereumTx, txBytes []byte,
+	check func(queued *evmtypes.MsgEthereumTx) error,
+) (queued bool, err error) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	tx, ok := q.txs[sender][nonce]
+	if !ok {
+		return false, nil
+	}
+	if err = check(tx.msg); err != nil {
+		return true, err
+	}
+
+	q.txs[sender][nonce] = queuedTx{msg: msg, txBytes: txBytes}
+	return true, nil
+}
+
+// Pop removes and returns the eth tx queued for the sender and nonce along with its encoded cosmos tx.
+func (q *TxQueue) Pop(sender common.Address, nonce uint64) (*evmtypes.MsgEthereumTx, []byte, bool) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	tx, ok := q.txs[sender][nonce]
+	if !ok {
+		return nil, nil, false
+	}
+	q.remove(sender, nonce)
+	return tx.msg, tx.txBytes, true
+}
+
+// Prune drops the queued and sent txs of the sender with a nonce lower than the given one, they are executed or
+// can't be executed anymore.
+func (q *TxQueue) Prune(sender common.Address, nonce uint64) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	for n := range q.txs[sender] {
+		if n < nonce {
+			q.remove(sender, n)
+		}
+	}
+	for n := range q.sent[sender] {
+		if n < nonce {
+			q.removeSent(sender, n)
+		}
+	}
+}
+
+// Senders returns the senders having queued or sent txs.
+func (q *TxQueue) Senders() []common.Address {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	senders := make([]common.Address, 0, len(q.txs))
+	for sender := range q.txs {
+		senders = append(senders, sender)
+	}
+	for sender := range q.sent {
+		if _, ok := q.txs[sender]; !ok {
+			senders = append(senders, sender)
+		}
+	}
+	return senders
+}
+
+// MarkSent tracks the eth tx of the sender broadcasted by the node, it replaces the sent tx with the same nonce if
+// any. It returns false if the tx can't be tracked because the global cap is reached.
+func (q *TxQueue) MarkSent(sender common.Address, msg *evmtypes.MsgEthereumTx, txBytes []byte) bool {
+	if !q.Enabled() {
+		return false
+	}
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	nonce := msg.AsTransaction().Nonce()
+	senderTxs, ok := q.sent[sender]
+	if !ok {
+		senderTxs = make(map[uint64]queuedTx)
+		q.sent[sender] = senderTxs
+	}
+	if _, ok := senderTxs[nonce]; !ok {
+		if q.sentSize >= q.cap {
+			if len(senderTxs) == 0 {
+				delete(q.sent, sender)
+			}
+			return false
+		}
+		q.sentSize++
+	}
+
+	senderTxs[nonce] = queuedTx{msg: msg, txBytes: txBytes}
+	return true
+}
+
+// sentFrom returns the sent txs of the sender from the given nonce, sorted by nonce.
+func (q *TxQueue) sentFrom(sender common.Address, nonce uint64) []queuedTx {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	var txs []queuedTx
+	for n, tx := range q.sent[sender] {
+		if n >= nonce {
+			txs = append(txs, tx)
+		}
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].msg.AsTransaction().Nonce() < txs[j].msg.AsTransaction().Nonce()
+	})
+	return txs
+}
+
+// RemoveSent stops tracking the sent tx of the sender with the given nonce.
+func (q *TxQueue) RemoveSent(sender common.Address, nonce uint64) {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	if _, ok := q.sent[sender][nonce]; ok {
+		q.removeSent(sender, nonce)
+	}
+}
+
+// Txs returns the queued eth txs of the given senders, or of all the senders if none is given, sorted by nonce
+// for each sender.
+func (q *TxQueue) Txs(senders ...common.Address) []*evmtypes.MsgEthereumTx {
+	if len(senders) == 0 {
+		senders = q.Senders()
+	}
+
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+
+	var result []*evmtypes.MsgEthereumTx
+	for _, sender := range senders {
+		nonces := make([]uint64, 0, len(q.txs[sender]))
+		for nonce := range q.txs[sender] {
+			nonces = append(nonces, nonce)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+		for _, nonce := range nonces {
+			result = append(result, q.txs[sender][nonce].msg)
+		}
+	}
+	return result
+}
+
+// remove deletes a queued tx, the caller must hold the lock.
+func (q *TxQueue) remove(sender common.Address, nonce uint64) {
+	delete(q.txs[sender], nonce)
+	q.size--
+	if len(q.txs[sender]) == 0 {
+		delete(q.txs, sender)
+	}
+}
+
+// removeSent deletes a sent tx, the caller must hold the lock.
+func (q *TxQueue) removeSent(sender common.Address, nonce uint64) {
+	delete(q.sent[sender], nonce)
+	q.sentSize--
+	if len(q.sent[sender]) == 0 {
+		delete(q.sent, sender)
+	}
+}
+
+// IsPriceBumped returns true if the effective tip of the new tx at the given base fee is higher than the one of the
+// old tx by at least priceBump percent, so the new tx can replace the old one with the same sender and nonce.
+func IsPriceBumped(oldTx, newTx *ethtypes.Transaction, baseFee *big.Int, priceBump uint64) bool {
+	oldTip := oldTx.EffectiveGasTipValue(baseFee)
+	newTip := newTx.EffectiveGasTipValue(baseFee)
+
+	threshold := new(big.Int).Mul(oldTip, new(big.Int).SetUint64(100+priceBump))
+	threshold.Quo(threshold, big.NewInt(100))
+	return newTip.Cmp(oldTip) > 0 && newTip.Cmp(threshold) >= 0
+}
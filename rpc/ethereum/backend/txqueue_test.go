@@ -0,0 +1,192 @@
+package backend
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func TestTxQueue(t *testing.T) {
+	sender1 := common.BigToAddress(big.NewInt(1))
+	sender2 := common.BigToAddress(big.NewInt(2))
+	to := common.BigToAddress(big.NewInt(3))
+
+	newTx := func(nonce uint64) *evmtypes.MsgEthereumTx {
+		return evmtypes.NewTx(nil, nonce, &to, big.NewInt(1), 21000, big.NewInt(1), nil, nil, nil, nil)
+	}
+
+	require.False(t, NewTxQueue(0, 10).Enabled())
+	require.False(t, NewTxQueue(10, 0).Enabled())
+	require.Error(t, NewTxQueue(0, 10).Add(sender1, newTx(1), []byte{1}))
+
+	queue := NewTxQueue(2, 3)
+	require.True(t, queue.Enabled())
+
+	require.NoError(t, queue.Add(sender1, newTx(3), []byte{3}))
+	require.NoError(t, queue.Add(sender1, newTx(2), []byte{2}))
+	// the sender cap is reached
+	require.Error(t, queue.Add(sender1, newTx(4), []byte{4}))
+	// replacing a queued nonce doesn't count against the caps
+	require.NoError(t, queue.Add(sender1, newTx(2), []byte{22}))
+
+	require.NoError(t, queue.Add(sender2, newTx(5), []byte{5}))
+	// the global cap is reached
+	require.Error(t, queue.Add(sender2, newTx(6), []byte{6}))
+
+	require.ElementsMatch(t, []common.Address{sender1, sender2}, queue.Senders())
+	txs := queue.Txs(sender1)
+	require.Len(t, txs, 2)
+	require.Equal(t, uint64(2), txs[0].AsTransaction().Nonce())
+	require.Equal(t, uint64(3), txs[1].AsTransaction().Nonce())
+	require.Len(t, queue.Txs(), 3)
+
+	msg, ok := queue.Get(sender1, 2)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), msg.AsTransaction().Nonce())
+
+	msg, txBytes, ok := queue.Pop(sender1, 2)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), msg.AsTransaction().Nonce())
+	require.Equal(t, []byte{22}, txBytes)
+	_, _, ok = queue.Pop(sender1, 2)
+	require.False(t, ok)
+	_, ok = queue.Get(sender1, 2)
+	require.False(t, ok)
+
+	// the executed nonces are pruned
+	queue.Prune(sender2, 6)
+	require.Empty(t, queue.Txs(sender2))
+	require.Equal(t, []common.Address{sender1}, queue.Senders())
+
+	// the freed slots can be reused
+	require.NoError(t, queue.Add(sender2, newTx(7), []byte{7}))
+	require.NoError(t, queue.Add(sender2, newTx(8), []byte{8}))
+}
+
+func TestTxQueueSent(t *testing.T) {
+	sender1 := common.BigToAddress(big.NewInt(1))
+	sender2 := common.BigToAddress(big.NewInt(2))
+	to := common.BigToAddress(big.NewInt(3))
+
+	newTx := func(nonce uint64) *evmtypes.MsgEthereumTx {
+		return evmtypes.NewTx(nil, nonce, &to, big.NewInt(1), 21000, big.NewInt(1), nil, nil, nil, nil)
+	}
+
+	require.False(t, NewTxQueue(0, 10).MarkSent(sender1, newTx(1), []byte{1}))
+
+	queue := NewTxQueue(2, 3)
+	require.True(t, queue.MarkSent(sender1, newTx(3), []byte{3}))
+	require.True(t, queue.MarkSent(sender1, newTx(1), []byte{1}))
+	require.True(t, queue.MarkSent(sender1, newTx(2), []byte{2}))
+	// the sender cap doesn't apply to the sent txs, the global cap does
+	require.False(t, queue.MarkSent(sender2, newTx(1), []byte{1}))
+	// replacing a sent nonce doesn't count against the cap
+	require.True(t, queue.MarkSent(sender1, newTx(2), []byte{22}))
+
+	// the senders of the sent txs are processed, but they have no queued txs
+	require.Equal(t, []common.Address{sender1}, queue.Senders())
+	require.Empty(t, queue.Txs())
+
+	sent := queue.sentFrom(sender1, 2)
+	require.Len(t, sent, 2)
+	require.Equal(t, []byte{22}, sent[0].txBytes)
+	require.Equal(t, uint64(3), sent[1].msg.AsTransaction().Nonce())
+	require.Empty(t, queue.sentFrom(sender2, 0))
+
+	queue.RemoveSent(sender1, 3)
+	require.Len(t, queue.sentFrom(sender1, 0), 2)
+
+	// the executed nonces are pruned and free the slots
+	queue.Prune(sender1, 2)
+	require.Len(t, queue.sentFrom(sender1, 0), 1)
+	require.True(t, queue.MarkSent(sender2, newTx(1), []byte{1}))
+	require.True(t, queue.MarkSent(sender2, newTx(2), []byte{2}))
+
+	queue.Prune(sender1, 3)
+	require.Equal(t, []common.Address{sender2}, queue.Senders())
+}
+
+func TestTxQueueReplace(t *testing.T) {
+	sender := common.BigToAddress(big.NewInt(1))
+	to := common.BigToAddress(big.NewInt(3))
+
+	newTx := func(nonce uint64, gasPrice int64) *evmtypes.MsgEthereumTx {
+		return evmtypes.NewTx(nil, nonce, &to, big.NewInt(1), 21000, big.NewInt(gasPrice), nil, nil, nil, nil)
+	}
+	accept := func(*evmtypes.MsgEthereumTx) error { return nil }
+
+	queue := NewTxQueue(2, 3)
+
+	// no tx is queued for the nonce
+	queued, err := queue.Replace(sender, 2, newTx(2, 2), []byte{22}, accept)
+	require.False(t, queued)
+	require.NoError(t, err)
+	require.Empty(t, queue.Txs())
+
+	require.NoError(t, queue.Add(sender, newTx(2, 1), []byte{2}))
+
+	// the rejected replacement keeps the queued tx
+	var checked *evmtypes.MsgEthereumTx
+	reject := func(queued *evmtypes.MsgEthereumTx) error {
+		checked = queued
+		return errors.New("underpriced")
+	}
+	queued, err = queue.Replace(sender, 2, newTx(2, 2), []byte{22}, reject)
+	require.True(t, queued)
+	require.Error(t, err)
+	require.Equal(t, big.NewInt(1), checked.AsTransaction().GasPrice())
+
+	queued, err = queue.Replace(sender, 2, newTx(2, 2), []byte{22}, accept)
+	require.True(t, queued)
+	require.NoError(t, err)
+
+	msg, txBytes, ok := queue.Pop(sender, 2)
+	require.True(t, ok)
+	require.Equal(t, big.NewInt(2), msg.AsTransaction().GasPrice())
+	require.Equal(t, []byte{22}, txBytes)
+	require.Empty(t, queue.Txs())
+}
+
+func TestIsPriceBumped(t *testing.T) {
+	legacyTx := func(gasPrice int64) *ethtypes.Transaction {
+		return ethtypes.NewTx(&ethtypes.LegacyTx{GasPrice: big.NewInt(gasPrice), Gas: 21000})
+	}
+	dynamicFeeTx := func(gasFeeCap, gasTipCap int64) *ethtypes.Transaction {
+		return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+			GasFeeCap: big.NewInt(gasFeeCap),
+			GasTipCap: big.NewInt(gasTipCap),
+			Gas:       21000,
+		})
+	}
+
+	testCases := []struct {
+		name      string
+		oldTx     *ethtypes.Transaction
+		newTx     *ethtypes.Transaction
+		baseFee   *big.Int
+		priceBump uint64
+		expBumped bool
+	}{
+		{"legacy, same price", legacyTx(100), legacyTx(100), nil, 10, false},
+		{"legacy, bump too low", legacyTx(100), legacyTx(109), nil, 10, false},
+		{"legacy, exact bump", legacyTx(100), legacyTx(110), nil, 10, true},
+		{"legacy, zero bump requires a higher price", legacyTx(100), legacyTx(100), nil, 0, false},
+		{"legacy, zero bump", legacyTx(100), legacyTx(101), nil, 0, true},
+		{"dynamic fee, tip bumped", dynamicFeeTx(200, 10), dynamicFeeTx(200, 11), big.NewInt(100), 10, true},
+		{"dynamic fee, tip capped by the fee cap", dynamicFeeTx(105, 5), dynamicFeeTx(105, 50), big.NewInt(100), 10, false},
+		{"dynamic fee, fee cap and tip bumped", dynamicFeeTx(105, 5), dynamicFeeTx(150, 50), big.NewInt(100), 10, true},
+		{"legacy replaced by dynamic fee", legacyTx(110), dynamicFeeTx(200, 11), big.NewInt(100), 10, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expBumped, IsPriceBumped(tc.oldTx, tc.newTx, tc.baseFee, tc.priceBump))
+		})
+	}
+}
@@ -2,6 +2,7 @@ package debug
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -26,6 +27,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/tendermint/tendermint/libs/log"
 	"github.com/tharsis/ethermint/rpc/ethereum/backend"
@@ -69,7 +72,7 @@ func NewAPI(
 
 // TraceTransaction returns the structured logs created during the execution of EVM
 // and returns them as a JSON object.
-func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (interface{}, error) {
+func (a *API) TraceTransaction(hash common.Hash, config *rpctypes.TraceConfig) (interface{}, error) {
 	a.logger.Debug("debug_traceTransaction", "hash", hash)
 	// Get transaction by hash
 	transaction, err := a.backend.GetTxByEthHash(hash)
@@ -89,19 +92,14 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 		return nil, err
 	}
 
-	msgIndex, _ := rpctypes.FindTxAttributes(transaction.TxResult.Events, hash.Hex())
-	if msgIndex < 0 {
-		return nil, fmt.Errorf("ethereum tx not found in msgs: %s", hash.Hex())
-	}
-
 	// check tx index is not out of bound
-	if uint32(len(blk.Block.Txs)) < transaction.Index {
-		a.logger.Debug("tx index out of bounds", "index", transaction.Index, "hash", hash.String(), "height", blk.Block.Height)
+	if uint32(len(blk.Block.Txs)) <= transaction.TxIndex {
+		a.logger.Debug("tx index out of bounds", "index", transaction.TxIndex, "hash", hash.String(), "height", blk.Block.Height)
 		return nil, fmt.Errorf("transaction not included in block %v", blk.Block.Height)
 	}
 
 	var predecessors []*evmtypes.MsgEthereumTx
-	for _, txBz := range blk.Block.Txs[:transaction.Index] {
+	for _, txBz := range blk.Block.Txs[:transaction.TxIndex] {
 		tx, err := a.clientCtx.TxConfig.TxDecoder()(txBz)
 		if err != nil {
 			a.logger.Debug("failed to decode transaction in block", "height", blk.Block.Height, "error", err.Error())
@@ -117,14 +115,14 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 		}
 	}
 
-	tx, err := a.clientCtx.TxConfig.TxDecoder()(transaction.Tx)
+	tx, err := a.clientCtx.TxConfig.TxDecoder()(blk.Block.Txs[transaction.TxIndex])
 	if err != nil {
 		a.logger.Debug("tx not found", "hash", hash)
 		return nil, err
 	}
 
 	// add predecessor messages in current cosmos tx
-	for i := 0; i < msgIndex; i++ {
+	for i := 0; i < int(transaction.MsgIndex); i++ {
 		ethMsg, ok := tx.GetMsgs()[i].(*evmtypes.MsgEthereumTx)
 		if !ok {
 			continue
@@ -132,7 +130,7 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 		predecessors = append(predecessors, ethMsg)
 	}
 
-	ethMessage, ok := tx.GetMsgs()[msgIndex].(*evmtypes.MsgEthereumTx)
+	ethMessage, ok := tx.GetMsgs()[transaction.MsgIndex].(*evmtypes.MsgEthereumTx)
 	if !ok {
 		a.logger.Debug("invalid transaction type", "type", fmt.Sprintf("%T", tx))
 		return nil, fmt.Errorf("invalid transaction type %T", tx)
@@ -147,7 +145,7 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 	}
 
 	if config != nil {
-		traceTxRequest.TraceConfig = config
+		traceTxRequest.TraceConfig = config.EVMTraceConfig()
 	}
 
 	// minus one to get the context of block beginning
@@ -172,9 +170,70 @@ func (a *API) TraceTransaction(hash common.Hash, config *evmtypes.TraceConfig) (
 	return decodedResult, nil
 }
 
+// TraceCall returns the structured logs created during the execution of EVM if the
+// call was executed on top of the state of the given block, with the state and block
+// overrides of the config applied, and returns them as a JSON object.
+func (a *API) TraceCall(
+	args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, config *rpctypes.TraceCallConfig,
+) (interface{}, error) {
+	a.logger.Debug("debug_traceCall", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNr := rpctypes.EthLatestBlockNumber
+	switch {
+	case blockNrOrHash.BlockHash != nil:
+		header, err := a.backend.HeaderByHash(*blockNrOrHash.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		blockNr = rpctypes.NewBlockNumber(header.Number)
+	case blockNrOrHash.BlockNumber != nil:
+		blockNr = *blockNrOrHash.BlockNumber
+	}
+	if blockNr == rpctypes.EthPendingBlockNumber {
+		// the block and state overrides are the way to trace a call in a future block
+		return nil, errors.New("tracing on top of pending is not supported")
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := evmtypes.QueryTraceCallRequest{
+		Args:   bz,
+		GasCap: a.backend.RPCGasCap(),
+	}
+	if config != nil {
+		req.TraceConfig = config.EVMTraceConfig()
+		if config.StateOverrides != nil {
+			if req.StateOverrides, err = json.Marshal(config.StateOverrides); err != nil {
+				return nil, err
+			}
+		}
+		if config.BlockOverrides != nil {
+			if req.BlockOverrides, err = json.Marshal(config.BlockOverrides); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	traceResult, err := a.queryClient.TraceCall(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response format is unknown due to custom tracer config param
+	var decodedResult interface{}
+	if err := json.Unmarshal(traceResult.Data, &decodedResult); err != nil {
+		return nil, err
+	}
+
+	return decodedResult, nil
+}
+
 // TraceBlockByNumber returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *rpctypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByNumber", "height", height)
 	if height == 0 {
 		return nil, errors.New("genesis is not traceable")
@@ -191,27 +250,56 @@ func (a *API) TraceBlockByNumber(height rpctypes.BlockNumber, config *evmtypes.T
 
 // TraceBlockByHash returns the structured logs created during the execution of
 // EVM and returns them as a JSON object.
-func (a *API) TraceBlockByHash(hash common.Hash, config *evmtypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) TraceBlockByHash(hash common.Hash, config *rpctypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
 	a.logger.Debug("debug_traceBlockByHash", "hash", hash)
 	// Get Tendermint Block
-	resBlock, err := a.backend.GetTendermintBlockByHash(hash)
+	resBlock, err := a.getTendermintBlockByHash(hash)
 	if err != nil {
-		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
 		return nil, err
 	}
 
-	if resBlock == nil || resBlock.Block == nil {
-		a.logger.Debug("block not found", "hash", hash.Hex())
-		return nil, errors.New("block not found")
+	return a.traceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
+}
+
+// TraceBlock returns the structured logs created during the execution of EVM of the txs of
+// the RLP encoded block, on top of the state of its parent height. The block doesn't need to
+// be part of the chain, so a block that failed to be proposed can be traced.
+func (a *API) TraceBlock(blob hexutil.Bytes, config *rpctypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+	a.logger.Debug("debug_traceBlock", "size", len(blob))
+	block := new(ethtypes.Block)
+	if err := rlp.DecodeBytes(blob, block); err != nil {
+		return nil, fmt.Errorf("could not decode block: %w", err)
 	}
+	return a.traceRLPBlock(block, config)
+}
 
-	return a.traceBlock(rpctypes.BlockNumber(resBlock.Block.Height), config, resBlock)
+// traceRLPBlock traces the txs of a decoded eth block on top of the state of its parent height.
+func (a *API) traceRLPBlock(block *ethtypes.Block, config *rpctypes.TraceConfig) ([]*evmtypes.TxTraceResult, error) {
+	if block.NumberU64() == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+	if len(block.Transactions()) == 0 {
+		return []*evmtypes.TxTraceResult{}, nil
+	}
+
+	txsMessages := make([]*evmtypes.MsgEthereumTx, 0, len(block.Transactions()))
+	for i, tx := range block.Transactions() {
+		ethMessage := &evmtypes.MsgEthereumTx{}
+		if err := ethMessage.FromEthereumTx(tx); err != nil {
+			return nil, fmt.Errorf("invalid transaction %d: %w", i, err)
+		}
+		txsMessages = append(txsMessages, ethMessage)
+	}
+
+	height := rpctypes.BlockNumber(block.Number().Int64())
+	blockTime := time.Unix(int64(block.Time()), 0).UTC()
+	return a.traceBlockTxs(height, config, txsMessages, height.Int64(), blockTime, common.Bytes2Hex(block.Hash().Bytes()))
 }
 
 // traceBlock configures a new tracer according to the provided configuration, and
 // executes all the transactions contained within. The return value will be one item
 // per transaction, dependent on the requested tracer.
-func (a *API) traceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error) {
+func (a *API) traceBlock(height rpctypes.BlockNumber, config *rpctypes.TraceConfig, block *tmrpctypes.ResultBlock) ([]*evmtypes.TxTraceResult, error) {
 	txs := block.Block.Txs
 	txsLength := len(txs)
 
@@ -220,50 +308,72 @@ func (a *API) traceBlock(height rpctypes.BlockNumber, config *evmtypes.TraceConf
 		return []*evmtypes.TxTraceResult{}, nil
 	}
 
-	txDecoder := a.clientCtx.TxConfig.TxDecoder()
+	// the txs rejected by the ante handler are skipped, like in the transaction list of eth_getBlock
+	txsMessages, err := a.blockExecutedEthereumTxs(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.traceBlockTxs(
+		height, config, txsMessages, block.Block.Height, block.Block.Time, common.Bytes2Hex(block.BlockID.Hash),
+	)
+}
 
-	var txsMessages []*evmtypes.MsgEthereumTx
-	for i, tx := range txs {
-		decodedTx, err := txDecoder(tx)
-		if err != nil {
-			a.logger.Error("failed to decode transaction", "hash", txs[i].Hash(), "error", err.Error())
-			continue
+// traceBlockTxs traces the eth txs of a block on top of the state of the parent height. The
+// block is traced in chunks of bounded size so the large blocks don't exceed the query limits.
+// The queries don't share a state, so each chunk replays the txs of the previous chunks to
+// start from the right state and the replays grow with the square of the number of chunks:
+// the blocks whose chunks would replay more txs than the replay cap in total are traced in a
+// single query instead. The chunks are traced one after the other and the tracing stops at the
+// first failed chunk.
+func (a *API) traceBlockTxs(
+	height rpctypes.BlockNumber,
+	config *rpctypes.TraceConfig,
+	txsMessages []*evmtypes.MsgEthereumTx,
+	blockNumber int64,
+	blockTime time.Time,
+	blockHash string,
+) ([]*evmtypes.TxTraceResult, error) {
+	ctxWithHeight := blockBeginningContext(int64(height))
+
+	chunkSize := int(a.backend.RPCTraceBlockChunkSize())
+	chunks := (len(txsMessages) + chunkSize - 1) / chunkSize
+
+	// the chunk i replays the i * chunkSize txs before it
+	replayed := int64(chunkSize) * int64(chunks) * int64(chunks-1) / 2
+	if replayCap := int64(a.backend.RPCTraceBlockReplayCap()); replayed > replayCap {
+		a.logger.Debug(
+			"tracing the block in a single query", "txs", len(txsMessages), "replayed", replayed, "replay-cap", replayCap,
+		)
+		chunkSize = len(txsMessages)
+	}
+
+	decodedResults := make([]*evmtypes.TxTraceResult, 0, len(txsMessages))
+	for start := 0; start < len(txsMessages); start += chunkSize {
+		end := start + chunkSize
+		if end > len(txsMessages) {
+			end = len(txsMessages)
 		}
 
-		for _, msg := range decodedTx.GetMsgs() {
-			ethMessage, ok := msg.(*evmtypes.MsgEthereumTx)
-			if !ok {
-				// Just considers Ethereum transactions
-				continue
-			}
-			txsMessages = append(txsMessages, ethMessage)
+		traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
+			Txs:          txsMessages[start:end],
+			Predecessors: txsMessages[:start],
+			TraceConfig:  config.EVMTraceConfig(),
+			BlockNumber:  blockNumber,
+			BlockTime:    blockTime,
+			BlockHash:    blockHash,
 		}
-	}
-
-	// minus one to get the context at the beginning of the block
-	contextHeight := height - 1
-	if contextHeight < 1 {
-		// 0 is a special value for `ContextWithHeight`.
-		contextHeight = 1
-	}
-	ctxWithHeight := rpctypes.ContextWithHeight(int64(contextHeight))
 
-	traceBlockRequest := &evmtypes.QueryTraceBlockRequest{
-		Txs:         txsMessages,
-		TraceConfig: config,
-		BlockNumber: block.Block.Height,
-		BlockTime:   block.Block.Time,
-		BlockHash:   common.Bytes2Hex(block.BlockID.Hash),
-	}
-
-	res, err := a.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
-	if err != nil {
-		return nil, err
-	}
+		res, err := a.queryClient.TraceBlock(ctxWithHeight, traceBlockRequest)
+		if err != nil {
+			return nil, err
+		}
 
-	decodedResults := make([]*evmtypes.TxTraceResult, txsLength)
-	if err := json.Unmarshal(res.Data, &decodedResults); err != nil {
-		return nil, err
+		chunkResults := make([]*evmtypes.TxTraceResult, end-start)
+		if err := json.Unmarshal(res.Data, &chunkResults); err != nil {
+			return nil, err
+		}
+		decodedResults = append(decodedResults, chunkResults...)
 	}
 
 	return decodedResults, nil
@@ -493,9 +603,141 @@ func (a *API) SeedHash(number uint64) (string, error) {
 	return fmt.Sprintf("0x%x", ethash.SeedHash(number)), nil
 }
 
-// IntermediateRoots executes a block, and returns a list
-// of intermediate roots: the stateroot after each transaction.
-func (a *API) IntermediateRoots(hash common.Hash, _ *evmtypes.TraceConfig) ([]common.Hash, error) {
+// IntermediateRoots executes a block, and returns a list of intermediate roots: the state
+// root after each transaction. Ethermint doesn't keep a state trie, the roots are chained
+// hashes of the EVM state changes of the transactions, see the IntermediateRoots evm query,
+// they can be compared between nodes to find the transaction where the state diverged.
+func (a *API) IntermediateRoots(hash common.Hash, _ *rpctypes.TraceConfig) ([]common.Hash, error) {
 	a.logger.Debug("debug_intermediateRoots", "hash", hash)
-	return ([]common.Hash)(nil), nil
+	resBlock, err := a.getTendermintBlockByHash(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	txsMessages, err := a.blockExecutedEthereumTxs(resBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &evmtypes.QueryIntermediateRootsRequest{
+		Txs:         txsMessages,
+		BlockNumber: resBlock.Block.Height,
+		BlockTime:   resBlock.Block.Time,
+		BlockHash:   common.Bytes2Hex(resBlock.BlockID.Hash),
+	}
+
+	res, err := a.queryClient.IntermediateRoots(blockBeginningContext(resBlock.Block.Height), req)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make([]common.Hash, len(res.Roots))
+	for i, root := range res.Roots {
+		roots[i] = common.HexToHash(root)
+	}
+	return roots, nil
+}
+
+// StorageRangeAt returns the storage of the contract at the given block hash and transaction
+// index, before the transaction is executed. The storage entries are sorted by the hash of
+// their keys, the range starts at keyStart and holds up to maxResult entries.
+func (a *API) StorageRangeAt(
+	blockHash common.Hash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int,
+) (rpctypes.StorageRangeResult, error) {
+	a.logger.Debug("debug_storageRangeAt", "hash", blockHash, "txIndex", txIndex, "address", contractAddress)
+	if txIndex < 0 || maxResult < 0 {
+		return rpctypes.StorageRangeResult{}, errors.New("tx index and max result can't be negative")
+	}
+
+	resBlock, err := a.getTendermintBlockByHash(blockHash)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	txsMessages, err := a.blockExecutedEthereumTxs(resBlock)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+	if txIndex >= len(txsMessages) {
+		return rpctypes.StorageRangeResult{}, fmt.Errorf("transaction index %d out of range", txIndex)
+	}
+
+	req := &evmtypes.QueryStorageRangeAtRequest{
+		Predecessors: txsMessages[:txIndex],
+		Address:      contractAddress.Hex(),
+		KeyStart:     common.BytesToHash(keyStart).Hex(),
+		MaxResult:    uint64(maxResult),
+		BlockNumber:  resBlock.Block.Height,
+		BlockTime:    resBlock.Block.Time,
+		BlockHash:    common.Bytes2Hex(resBlock.BlockID.Hash),
+	}
+
+	res, err := a.queryClient.StorageRangeAt(blockBeginningContext(resBlock.Block.Height), req)
+	if err != nil {
+		return rpctypes.StorageRangeResult{}, err
+	}
+
+	result := rpctypes.StorageRangeResult{
+		Storage: make(map[common.Hash]rpctypes.StorageEntry, len(res.Storage)),
+	}
+	for _, state := range res.Storage {
+		key := common.HexToHash(state.Key)
+		result.Storage[crypto.Keccak256Hash(key.Bytes())] = rpctypes.StorageEntry{
+			Key:   &key,
+			Value: common.HexToHash(state.Value),
+		}
+	}
+	if res.NextKey != "" {
+		nextKey := common.HexToHash(res.NextKey)
+		result.NextKey = &nextKey
+	}
+	return result, nil
+}
+
+// blockExecutedEthereumTxs returns the eth txs of a block that passed the ante handler, in the
+// order of the transaction list of eth_getBlock, so their indexes match the eth tx indexes.
+func (a *API) blockExecutedEthereumTxs(resBlock *tmrpctypes.ResultBlock) ([]*evmtypes.MsgEthereumTx, error) {
+	blockRes, err := a.clientCtx.Client.BlockResults(context.Background(), &resBlock.Block.Height)
+	if err != nil {
+		a.logger.Debug("failed to retrieve block results", "height", resBlock.Block.Height, "error", err.Error())
+		return nil, err
+	}
+
+	ethTxs, err := rpctypes.ParseBlockEthTxs(a.clientCtx.TxConfig.TxDecoder(), resBlock.Block, blockRes.TxsResults)
+	if err != nil {
+		return nil, err
+	}
+
+	txsMessages := make([]*evmtypes.MsgEthereumTx, len(ethTxs))
+	for i, ethTx := range ethTxs {
+		txsMessages[i] = ethTx.Msg
+	}
+	return txsMessages, nil
+}
+
+// getTendermintBlockByHash returns the Tendermint block with the given hash, it fails if the
+// block is not found.
+func (a *API) getTendermintBlockByHash(hash common.Hash) (*tmrpctypes.ResultBlock, error) {
+	resBlock, err := a.backend.GetTendermintBlockByHash(hash)
+	if err != nil {
+		a.logger.Debug("get block failed", "hash", hash.Hex(), "error", err.Error())
+		return nil, err
+	}
+
+	if resBlock == nil || resBlock.Block == nil {
+		a.logger.Debug("block not found", "hash", hash.Hex())
+		return nil, errors.New("block not found")
+	}
+	return resBlock, nil
+}
+
+// blockBeginningContext returns the query context at the beginning of the block, on top of
+// the state of the previous block.
+func blockBeginningContext(height int64) context.Context {
+	contextHeight := height - 1
+	if contextHeight < 1 {
+		// 0 is a special value for `ContextWithHeight`.
+		contextHeight = 1
+	}
+	return rpctypes.ContextWithHeight(contextHeight)
 }
@@ -0,0 +1,237 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/encoding"
+	"github.com/tharsis/ethermint/rpc/ethereum/backend"
+	rpctypes "github.com/tharsis/ethermint/rpc/ethereum/types"
+	"github.com/tharsis/ethermint/tests"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// traceBackend sets the trace block limits.
+type traceBackend struct {
+	backend.Backend
+
+	chunkSize int32
+	replayCap int32
+	block     *tmrpctypes.ResultBlock
+}
+
+func (b *traceBackend) RPCTraceBlockChunkSize() int32 { return b.chunkSize }
+
+func (b *traceBackend) RPCTraceBlockReplayCap() int32 { return b.replayCap }
+
+func (b *traceBackend) GetTendermintBlockByHash(common.Hash) (*tmrpctypes.ResultBlock, error) {
+	return b.block, nil
+}
+
+// blockResultsClient returns the results of the txs of a block.
+type blockResultsClient struct {
+	tmrpcclient.Client
+
+	txsResults []*abci.ResponseDeliverTx
+}
+
+func (c blockResultsClient) BlockResults(_ context.Context, height *int64) (*tmrpctypes.ResultBlockResults, error) {
+	return &tmrpctypes.ResultBlockResults{Height: *height, TxsResults: c.txsResults}, nil
+}
+
+// traceQueryClient records the trace block requests and returns the hashes of the traced txs as results.
+type traceQueryClient struct {
+	evmtypes.QueryClient
+
+	requests        []*evmtypes.QueryTraceBlockRequest
+	storageRequests []*evmtypes.QueryStorageRangeAtRequest
+}
+
+func (c *traceQueryClient) TraceBlock(
+	_ context.Context, req *evmtypes.QueryTraceBlockRequest, _ ...grpc.CallOption,
+) (*evmtypes.QueryTraceBlockResponse, error) {
+	c.requests = append(c.requests, req)
+
+	results := make([]*evmtypes.TxTraceResult, len(req.Txs))
+	for i, tx := range req.Txs {
+		results[i] = &evmtypes.TxTraceResult{Result: tx.Hash}
+	}
+	bz, err := json.Marshal(results)
+	if err != nil {
+		return nil, err
+	}
+	return &evmtypes.QueryTraceBlockResponse{Data: bz}, nil
+}
+
+func (c *traceQueryClient) StorageRangeAt(
+	_ context.Context, req *evmtypes.QueryStorageRangeAtRequest, _ ...grpc.CallOption,
+) (*evmtypes.QueryStorageRangeAtResponse, error) {
+	c.storageRequests = append(c.storageRequests, req)
+	return &evmtypes.QueryStorageRangeAtResponse{}, nil
+}
+
+func TestTraceBlock(t *testing.T) {
+	to := common.BigToAddress(big.NewInt(1))
+	newBlock := func(number int64, txs int) *ethtypes.Block {
+		ethTxs := make([]*ethtypes.Transaction, txs)
+		for i := range ethTxs {
+			ethTxs[i] = ethtypes.NewTransaction(uint64(i), to, big.NewInt(1), 21000, big.NewInt(1), nil)
+		}
+		header := &ethtypes.Header{Number: big.NewInt(number), Time: 1000}
+		return ethtypes.NewBlock(header, ethTxs, nil, nil, trie.NewStackTrie(nil))
+	}
+	encode := func(block *ethtypes.Block) []byte {
+		bz, err := rlp.EncodeToBytes(block)
+		require.NoError(t, err)
+		return bz
+	}
+
+	testCases := []struct {
+		name        string
+		blob        []byte
+		replayCap   int32
+		expRequests int
+		expErr      bool
+	}{
+		{"invalid rlp", []byte{1, 2, 3}, 10, 0, true},
+		{"genesis block", encode(newBlock(0, 1)), 10, 0, true},
+		{"empty block", encode(newBlock(5, 0)), 10, 0, false},
+		{"single chunk", encode(newBlock(5, 2)), 10, 1, false},
+		// the chunks replay 2 and 4 txs
+		{"chunks", encode(newBlock(5, 5)), 6, 3, false},
+		// the block is traced in a single query
+		{"replay cap exceeded", encode(newBlock(5, 5)), 5, 1, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			queryClient := &traceQueryClient{}
+			api := &API{
+				logger:      log.NewNopLogger(),
+				backend:     &traceBackend{chunkSize: 2, replayCap: tc.replayCap},
+				queryClient: &rpctypes.QueryClient{QueryClient: queryClient},
+			}
+
+			results, err := api.TraceBlock(tc.blob, nil)
+			require.Len(t, queryClient.requests, tc.expRequests)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			block := new(ethtypes.Block)
+			require.NoError(t, rlp.DecodeBytes(tc.blob, block))
+			require.Len(t, results, len(block.Transactions()))
+
+			// the results follow the order of the block txs
+			for i, tx := range block.Transactions() {
+				require.Equal(t, tx.Hash().Hex(), results[i].Result)
+			}
+
+			// each chunk replays the txs of the previous chunks in the context of the block
+			for i, req := range queryClient.requests {
+				require.Len(t, req.Predecessors, 2*i)
+				require.Equal(t, int64(5), req.BlockNumber)
+				require.Equal(t, time.Unix(1000, 0).UTC(), req.BlockTime)
+				require.Equal(t, common.Bytes2Hex(block.Hash().Bytes()), req.BlockHash)
+			}
+		})
+	}
+}
+
+func TestBlockExecutedTxs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := encoding.MakeConfig(module.NewBasicManager())
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig)
+
+	to := common.BigToAddress(big.NewInt(1))
+	buildTx := func(nonce uint64) (tmtypes.Tx, string) {
+		tx := evmtypes.NewTx(nil, nonce, &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil)
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+		return txBz, tx.Hash
+	}
+	txBz1, txHash1 := buildTx(0)
+	txBz2, txHash2 := buildTx(1)
+
+	ethTxEvent := func(hash, txIndex string) abci.Event {
+		return abci.Event{
+			Type: evmtypes.EventTypeEthereumTx,
+			Attributes: []abci.EventAttribute{
+				{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(hash)},
+				{Key: []byte(evmtypes.AttributeKeyTxIndex), Value: []byte(txIndex)},
+			},
+		}
+	}
+
+	block := &tmrpctypes.ResultBlock{
+		BlockID: tmtypes.BlockID{Hash: common.BigToHash(big.NewInt(5)).Bytes()},
+		Block: &tmtypes.Block{
+			Header: tmtypes.Header{Height: 5},
+			Data:   tmtypes.Data{Txs: []tmtypes.Tx{txBz1, txBz2, txBz2}},
+		},
+	}
+	txsResults := []*abci.ResponseDeliverTx{
+		{Code: 0, GasUsed: 21000, Events: []abci.Event{ethTxEvent(txHash1, "0")}},
+		// rejected by the ante handler
+		{Code: 11, GasUsed: 5000},
+		{Code: 0, GasUsed: 21000, Events: []abci.Event{ethTxEvent(txHash2, "1")}},
+	}
+
+	queryClient := &traceQueryClient{}
+	api := &API{
+		logger:      log.NewNopLogger(),
+		clientCtx:   clientCtx.WithClient(blockResultsClient{txsResults: txsResults}),
+		backend:     &traceBackend{chunkSize: 10, replayCap: 10, block: block},
+		queryClient: &rpctypes.QueryClient{QueryClient: queryClient},
+	}
+
+	// the block trace skips the rejected tx
+	results, err := api.traceBlock(rpctypes.BlockNumber(5), nil, block)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, txHash1, results[0].Result)
+	require.Equal(t, txHash2, results[1].Result)
+
+	// the storage range is queried before the tx at the index of the eth block
+	_, err = api.StorageRangeAt(common.Hash{}, 1, to, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, queryClient.storageRequests, 1)
+	require.Len(t, queryClient.storageRequests[0].Predecessors, 1)
+	require.Equal(t, txHash1, queryClient.storageRequests[0].Predecessors[0].Hash)
+
+	// the index of the eth block size is out of range
+	_, err = api.StorageRangeAt(common.Hash{}, 2, to, nil, 10)
+	require.Error(t, err)
+	require.Len(t, queryClient.storageRequests, 1)
+}
@@ -19,6 +19,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	tmrpctypes "github.com/tendermint/tendermint/rpc/core/types"
 
@@ -26,7 +27,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
@@ -268,6 +268,14 @@ func (e *PublicAPI) GetBalance(address common.Address, blockNrOrHash rpctypes.Bl
 		Address: address.String(),
 	}
 
+	// the pending balance includes the effects of the pending txs of the address
+	if blockNum == rpctypes.EthPendingBlockNumber {
+		req.PendingTxs, err = e.backend.PendingEthereumTxsFrom(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	res, err := e.queryClient.Balance(rpctypes.ContextWithHeight(blockNum.Int64()), req)
 	if err != nil {
 		return nil, err
@@ -405,12 +413,18 @@ func (e *PublicAPI) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, err
 		return nil, nil
 	}
 
-	msgIndex, _ := rpctypes.FindTxAttributes(res.TxResult.Events, hexTx)
-	if msgIndex < 0 {
-		return nil, fmt.Errorf("ethereum tx not found in msgs: %s", hexTx)
+	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &res.Height)
+	if err != nil {
+		e.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
+	}
+
+	if int(res.TxIndex) >= len(blockRes.TxsResults) {
+		return nil, fmt.Errorf("ethereum tx not found in block results: %s", hexTx)
 	}
+
 	// parse tx logs from events
-	return backend.TxLogsFromEvents(res.TxResult.Events, msgIndex)
+	return backend.TxLogsFromEvents(blockRes.TxsResults[res.TxIndex].Events, int(res.MsgIndex))
 }
 
 // Sign signs the provided data using the private key of address via Geth's signature standard.
@@ -493,7 +507,10 @@ func (e *PublicAPI) FillTransaction(args evmtypes.TransactionArgs) (*rpctypes.Si
 	}, nil
 }
 
-// SendRawTransaction send a raw Ethereum transaction.
+// SendRawTransaction send a raw Ethereum transaction. A tx with a future nonce is held by the local tx queue,
+// and a tx with the nonce of a queued tx, or of a pending tx sent through this node, replaces it if it bumps
+// its price enough. The replaced pending tx is only removed from the local mempool, see
+// backend.BroadcastEthereumTx.
 func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 	e.logger.Debug("eth_sendRawTransaction", "length", len(data))
 
@@ -537,12 +554,8 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 
 	txHash := ethereumTx.AsTransaction().Hash()
 
-	syncCtx := e.clientCtx.WithBroadcastMode(flags.BroadcastSync)
-	rsp, err := syncCtx.BroadcastTx(txBytes)
-	if rsp != nil && rsp.Code != 0 {
-		err = sdkerrors.ABCIError(rsp.Codespace, rsp.Code, rsp.RawLog)
-	}
-	if err != nil {
+	// the txs with a future nonce are queued until the nonce gap is filled
+	if err := e.backend.BroadcastEthereumTx(ethereumTx, txBytes); err != nil {
 		e.logger.Error("failed to broadcast tx", "error", err.Error())
 		return txHash, err
 	}
@@ -604,7 +617,7 @@ func (e *PublicAPI) Resend(ctx context.Context, args evmtypes.TransactionArgs, g
 	}
 
 	for _, tx := range pending {
-		// FIXME does Resend api possible at all?  https://github.com/tharsis/ethermint/issues/905
+		// the resent tx replaces the pending one if its price is bumped enough, see BroadcastEthereumTx
 		p, err := evmtypes.UnwrapEthereumMsg(tx, common.Hash{})
 		if err != nil {
 			// not valid ethereum tx
@@ -636,14 +649,14 @@ func (e *PublicAPI) Resend(ctx context.Context, args evmtypes.TransactionArgs, g
 }
 
 // Call performs a raw contract call.
-func (e *PublicAPI) Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, _ *rpctypes.StateOverride) (hexutil.Bytes, error) {
+func (e *PublicAPI) Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.BlockNumberOrHash, overrides *evmtypes.StateOverride) (hexutil.Bytes, error) {
 	e.logger.Debug("eth_call", "args", args.String(), "block number or hash", blockNrOrHash)
 
 	blockNum, err := e.getBlockNumber(blockNrOrHash)
 	if err != nil {
 		return nil, err
 	}
-	data, err := e.doCall(args, blockNum)
+	data, err := e.doCall(args, blockNum, overrides)
 	if err != nil {
 		return []byte{}, err
 	}
@@ -654,7 +667,7 @@ func (e *PublicAPI) Call(args evmtypes.TransactionArgs, blockNrOrHash rpctypes.B
 // DoCall performs a simulated call operation through the evmtypes. It returns the
 // estimated gas used on the operation or an error if fails.
 func (e *PublicAPI) doCall(
-	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber,
+	args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber, overrides *evmtypes.StateOverride,
 ) (*evmtypes.MsgEthereumTxResponse, error) {
 	bz, err := json.Marshal(&args)
 	if err != nil {
@@ -666,6 +679,21 @@ func (e *PublicAPI) doCall(
 		GasCap: e.backend.RPCGasCap(),
 	}
 
+	if overrides != nil {
+		req.Overrides, err = json.Marshal(overrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the pending call is executed on top of the pending txs of the sender
+	if blockNr == rpctypes.EthPendingBlockNumber {
+		req.PendingTxs, err = e.backend.PendingEthereumTxsFrom(args.GetFrom())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
@@ -700,6 +728,147 @@ func (e *PublicAPI) doCall(
 	return res, nil
 }
 
+// CreateAccessList creates an EIP-2930 type AccessList for the given transaction.
+// BlockNrOrHash can be specified to create the accessList on top of a certain state,
+// it defaults to the pending block.
+func (e *PublicAPI) CreateAccessList(args evmtypes.TransactionArgs, blockNrOrHash *rpctypes.BlockNumberOrHash) (*rpctypes.AccessListResult, error) {
+	e.logger.Debug("eth_createAccessList", "args", args.String(), "block number or hash", blockNrOrHash)
+
+	blockNum := rpctypes.EthPendingBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNum, err = e.getBlockNumber(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return nil, err
+	}
+
+	req := evmtypes.EthCallRequest{
+		Args:   bz,
+		GasCap: e.backend.RPCGasCap(),
+	}
+
+	res, err := e.queryClient.CreateAccessList(rpctypes.ContextWithHeight(blockNum.Int64()), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	// return an empty list rather than null when nothing was accessed
+	accessList := res.AccessList.ToEthAccessList()
+	if *accessList == nil {
+		*accessList = ethtypes.AccessList{}
+	}
+
+	return &rpctypes.AccessListResult{
+		AccessList: accessList,
+		Error:      res.VmError,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+	}, nil
+}
+
+// SimulateV1 executes the calls of a series of blocks on top of the given block,
+// every call sees the state changes of the previous ones and nothing is committed.
+func (e *PublicAPI) SimulateV1(opts rpctypes.SimOpts, blockNrOrHash *rpctypes.BlockNumberOrHash) ([]*rpctypes.SimBlockResult, error) {
+	e.logger.Debug("eth_simulateV1", "blocks", len(opts.BlockStateCalls), "block number or hash", blockNrOrHash)
+
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+
+	blockNum := rpctypes.EthLatestBlockNumber
+	if blockNrOrHash != nil {
+		var err error
+		blockNum, err = e.getBlockNumber(*blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bz, err := json.Marshal(opts.BlockStateCalls)
+	if err != nil {
+		return nil, err
+	}
+
+	req := evmtypes.SimulateCallsRequest{
+		Blocks: bz,
+		GasCap: e.backend.RPCGasCap(),
+	}
+
+	ctx := rpctypes.ContextWithHeight(blockNum.Int64())
+	timeout := e.backend.RPCEVMTimeout()
+
+	// the calls are canceled together after the evm timeout
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+
+	res, err := e.queryClient.SimulateCalls(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*rpctypes.SimBlockResult, 0, len(opts.BlockStateCalls))
+	for _, block := range opts.BlockStateCalls {
+		if len(res.Results) < len(block.Calls) {
+			return nil, fmt.Errorf("missing call results, expected %d", len(block.Calls))
+		}
+
+		blockResult := &rpctypes.SimBlockResult{Calls: make([]rpctypes.SimCallResult, len(block.Calls))}
+		for i, callRes := range res.Results[:len(block.Calls)] {
+			blockResult.Calls[i] = formatSimCallResult(callRes)
+		}
+		res.Results = res.Results[len(block.Calls):]
+
+		results = append(results, blockResult)
+	}
+
+	return results, nil
+}
+
+// formatSimCallResult converts the result of a simulated call to the json-rpc
+// format, the reverts are reported with the revert data.
+func formatSimCallResult(res *evmtypes.MsgEthereumTxResponse) rpctypes.SimCallResult {
+	logs := evmtypes.LogsToEthereum(res.Logs)
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	result := rpctypes.SimCallResult{
+		ReturnData: res.Ret,
+		Logs:       logs,
+		GasUsed:    hexutil.Uint64(res.GasUsed),
+		Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+
+	if res.Failed() {
+		result.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			revertErr := evmtypes.NewExecErrorWithReason(res.Ret)
+			result.Error = &rpctypes.SimCallError{
+				Code:    revertErr.ErrorCode(),
+				Message: revertErr.Error(),
+				Data:    revertErr.ErrorData().(string),
+			}
+		} else {
+			result.Error = &rpctypes.SimCallError{
+				Code:    rpctypes.ErrCodeVMError,
+				Message: res.VmError,
+			}
+		}
+	}
+
+	return result
+}
+
 // EstimateGas returns an estimate of gas usage for the given smart contract call.
 func (e *PublicAPI) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error) {
 	e.logger.Debug("eth_estimateGas")
@@ -727,25 +896,12 @@ func (e *PublicAPI) GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransac
 // getTransactionByBlockAndIndex is the common code shared by `GetTransactionByBlockNumberAndIndex` and `GetTransactionByBlockHashAndIndex`.
 func (e *PublicAPI) getTransactionByBlockAndIndex(block *tmrpctypes.ResultBlock, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
 	var msg *evmtypes.MsgEthereumTx
-	// try /tx_search first
+	// try the tx indexer first
 	res, err := e.backend.GetTxByTxIndex(block.Block.Height, uint(idx))
 	if err == nil {
-		tx, err := e.clientCtx.TxConfig.TxDecoder()(res.Tx)
+		msg, err = e.backend.GetEthereumMsgFromTxResult(block, res)
 		if err != nil {
-			e.logger.Debug("invalid ethereum tx", "height", block.Block.Header, "index", idx)
-			return nil, nil
-		}
-		// find msg index in events
-		msgIndex := rpctypes.FindTxAttributesByIndex(res.TxResult.Events, uint64(idx))
-		if msgIndex < 0 {
-			e.logger.Debug("invalid ethereum tx", "height", block.Block.Header, "index", idx)
-			return nil, nil
-		}
-		var ok bool
-		// msgIndex is inferred from tx events, should be within bound.
-		msg, ok = tx.GetMsgs()[msgIndex].(*evmtypes.MsgEthereumTx)
-		if !ok {
-			e.logger.Debug("invalid ethereum tx", "height", block.Block.Header, "index", idx)
+			e.logger.Debug("invalid ethereum tx", "height", block.Block.Header, "index", idx, "error", err.Error())
 			return nil, nil
 		}
 	} else {
@@ -820,64 +976,118 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interfac
 		return nil, nil
 	}
 
-	msgIndex, attrs := rpctypes.FindTxAttributes(res.TxResult.Events, hexTx)
-	if msgIndex < 0 {
-		return nil, fmt.Errorf("ethereum tx not found in msgs: %s", hexTx)
-	}
-
 	resBlock, err := e.clientCtx.Client.Block(e.ctx, &res.Height)
 	if err != nil {
 		e.logger.Debug("block not found", "height", res.Height, "error", err.Error())
 		return nil, nil
 	}
 
-	tx, err := e.clientCtx.TxConfig.TxDecoder()(res.Tx)
+	ethMsg, err := e.backend.GetEthereumMsgFromTxResult(resBlock, res)
 	if err != nil {
-		e.logger.Debug("decoding failed", "error", err.Error())
-		return nil, fmt.Errorf("failed to decode tx: %w", err)
+		e.logger.Debug("invalid ethereum tx", "hash", hexTx, "error", err.Error())
+		return nil, err
 	}
 
-	// the `msgIndex` is inferred from tx events, should be within the bound.
-	msg := tx.GetMsgs()[msgIndex]
-	ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
-	if !ok {
-		e.logger.Debug(fmt.Sprintf("invalid tx type: %T", msg))
-		return nil, fmt.Errorf("invalid tx type: %T", msg)
+	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &res.Height)
+	if err != nil {
+		e.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		return nil, nil
 	}
 
-	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	var baseFee *big.Int
+	if ethMsg.AsTransaction().Type() == ethtypes.DynamicFeeTxType {
+		baseFee, err = e.backend.BaseFee(res.Height)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var txResult *abci.ResponseDeliverTx
+	if int(res.TxIndex) < len(blockRes.TxsResults) {
+		txResult = blockRes.TxsResults[res.TxIndex]
+	}
+
+	return e.formatTxReceipt(ethMsg, res, common.BytesToHash(resBlock.Block.Header.Hash()), txResult, baseFee)
+}
+
+// GetBlockReceipts returns the receipts of all the transactions in a block,
+// the block and its results are only fetched once.
+func (e *PublicAPI) GetBlockReceipts(blockNrOrHash rpctypes.BlockNumberOrHash) ([]map[string]interface{}, error) {
+	e.logger.Debug("eth_getBlockReceipts", "block number or hash", blockNrOrHash)
+
+	var (
+		resBlock *tmrpctypes.ResultBlock
+		err      error
+	)
+	if blockNrOrHash.BlockHash != nil {
+		resBlock, err = e.backend.GetTendermintBlockByHash(*blockNrOrHash.BlockHash)
+	} else {
+		var blockNum rpctypes.BlockNumber
+		blockNum, err = e.getBlockNumber(blockNrOrHash)
+		if err != nil {
+			return nil, err
+		}
+		resBlock, err = e.backend.GetTendermintBlockByNumber(blockNum)
+	}
 	if err != nil {
-		e.logger.Error("failed to unpack tx data", "error", err.Error())
 		return nil, err
 	}
+	// return nil if the block is not found
+	if resBlock == nil || resBlock.Block == nil {
+		return nil, nil
+	}
 
-	cumulativeGasUsed := uint64(0)
-	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &res.Height)
+	height := resBlock.Block.Height
+	blockRes, err := e.clientCtx.Client.BlockResults(e.ctx, &height)
 	if err != nil {
-		e.logger.Debug("failed to retrieve block results", "height", res.Height, "error", err.Error())
+		e.logger.Debug("failed to retrieve block results", "height", height, "error", err.Error())
 		return nil, nil
 	}
 
-	for i := 0; i < int(res.Index) && i < len(blockRes.TxsResults); i++ {
-		cumulativeGasUsed += uint64(blockRes.TxsResults[i].GasUsed)
+	ethTxs, err := rpctypes.ParseBlockEthTxs(e.clientCtx.TxConfig.TxDecoder(), resBlock.Block, blockRes.TxsResults)
+	if err != nil {
+		e.logger.Debug("failed to parse the eth txs of block", "height", height, "error", err.Error())
+		return nil, err
 	}
-	cumulativeGasUsed += rpctypes.AccumulativeGasUsedOfMsg(res.TxResult.Events, msgIndex)
 
-	var gasUsed uint64
-	if len(tx.GetMsgs()) == 1 {
-		// backward compatibility
-		gasUsed = uint64(res.TxResult.GasUsed)
-	} else {
-		gasUsed, err = rpctypes.GetUint64Attribute(attrs, evmtypes.AttributeKeyTxGasUsed)
+	baseFee, err := e.backend.BaseFee(height)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := common.BytesToHash(resBlock.Block.Header.Hash())
+	receipts := make([]map[string]interface{}, 0, len(ethTxs))
+	for i := range ethTxs {
+		ethTx := &ethTxs[i]
+		receipt, err := e.formatTxReceipt(ethTx.Msg, &ethTx.Result, blockHash, blockRes.TxsResults[ethTx.Result.TxIndex], baseFee)
 		if err != nil {
 			return nil, err
 		}
+		receipts = append(receipts, receipt)
+	}
+
+	return receipts, nil
+}
+
+// formatTxReceipt builds the receipt of an eth tx from its result and the
+// result of the cosmos tx containing it, baseFee is only used by the dynamic
+// fee txs.
+func (e *PublicAPI) formatTxReceipt(
+	ethMsg *evmtypes.MsgEthereumTx,
+	res *ethermint.TxResult,
+	blockHash common.Hash,
+	txResult *abci.ResponseDeliverTx,
+	baseFee *big.Int,
+) (map[string]interface{}, error) {
+	txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+	if err != nil {
+		e.logger.Error("failed to unpack tx data", "error", err.Error())
+		return nil, err
 	}
 
-	// Get the transaction result from the log
-	_, found := attrs[evmtypes.AttributeKeyEthereumTxFailed]
+	// Get the transaction status from the tx result
 	var status hexutil.Uint
-	if found {
+	if res.Failed {
 		status = hexutil.Uint(ethtypes.ReceiptStatusFailed)
 	} else {
 		status = hexutil.Uint(ethtypes.ReceiptStatusSuccessful)
@@ -888,51 +1098,36 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interfac
 		return nil, err
 	}
 
-	// parse tx logs from events
-	logs, err := backend.TxLogsFromEvents(res.TxResult.Events, msgIndex)
-	if err != nil {
-		e.logger.Debug("logs not found", "hash", hexTx, "error", err.Error())
+	var events []abci.Event
+	if txResult != nil {
+		events = txResult.Events
 	}
 
-	// Try to find txIndex from events
-	found = false
-	txIndex, err := rpctypes.GetUint64Attribute(attrs, evmtypes.AttributeKeyTxIndex)
-	if err == nil {
-		found = true
-	} else {
-		// Fallback to find tx index by iterating all valid eth transactions
-		msgs := e.backend.GetEthereumMsgsFromTendermintBlock(resBlock, blockRes)
-		for i := range msgs {
-			if msgs[i].Hash == hexTx {
-				txIndex = uint64(i)
-				found = true
-				break
-			}
-		}
-	}
-	if !found {
-		return nil, errors.New("can't find index of ethereum tx")
+	// parse tx logs from events
+	logs, err := backend.TxLogsFromEvents(events, int(res.MsgIndex))
+	if err != nil {
+		e.logger.Debug("logs not found", "hash", ethMsg.Hash, "error", err.Error())
 	}
 
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
 		"status":            status,
-		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
+		"cumulativeGasUsed": hexutil.Uint64(res.CumulativeGasUsed),
 		"logsBloom":         ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
 		"logs":              logs,
 
 		// Implementation fields: These fields are added by geth when processing a transaction.
 		// They are stored in the chain database.
-		"transactionHash": hash,
+		"transactionHash": common.HexToHash(ethMsg.Hash),
 		"contractAddress": nil,
-		"gasUsed":         hexutil.Uint64(gasUsed),
+		"gasUsed":         hexutil.Uint64(res.GasUsed),
 		"type":            hexutil.Uint(txData.TxType()),
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
-		"blockHash":        common.BytesToHash(resBlock.Block.Header.Hash()).Hex(),
+		"blockHash":        blockHash.Hex(),
 		"blockNumber":      hexutil.Uint64(res.Height),
-		"transactionIndex": hexutil.Uint64(txIndex),
+		"transactionIndex": hexutil.Uint64(res.EthTxIndex),
 
 		// sender and receiver (contract or EOA) addreses
 		"from": from,
@@ -948,14 +1143,20 @@ func (e *PublicAPI) GetTransactionReceipt(hash common.Hash) (map[string]interfac
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
-	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
-		baseFee, err := e.backend.BaseFee(res.Height)
-		if err != nil {
-			return nil, err
-		}
+	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok && baseFee != nil {
 		receipt["effectiveGasPrice"] = hexutil.Big(*dynamicTx.GetEffectiveGasPrice(baseFee))
 	}
 
+	// attach the revert data of the reverted txs, so the clients can decode the
+	// reason without replaying the tx.
+	if res.Failed && txResult != nil {
+		if responses, err := evmtypes.DecodeTxResponses(txResult.Data); err == nil && int(res.MsgIndex) < len(responses) {
+			if responses[res.MsgIndex].VmError == vm.ErrExecutionReverted.Error() {
+				receipt["revertReason"] = hexutil.Bytes(responses[res.MsgIndex].Revert())
+			}
+		}
+	}
+
 	return receipt, nil
 }
 
@@ -1006,7 +1207,12 @@ func (e *PublicAPI) GetUncleByBlockNumberAndIndex(number, idx hexutil.Uint) map[
 	return nil
 }
 
-// GetProof returns an account object with proof and any storage proofs
+// GetProof returns an account object with proof and any storage proofs. The proofs
+// are ICS-23 commitment proofs of the state at the end of the queried block, they
+// can be verified with rpctypes.VerifyAccountProof and rpctypes.VerifyStorageProof
+// against the app hash of the header at the returned proof height, the height of
+// the block following the queried one. The proofs of the latest block can only be
+// verified once the next block is committed.
 func (e *PublicAPI) GetProof(address common.Address, storageKeys []string, blockNrOrHash rpctypes.BlockNumberOrHash) (*rpctypes.AccountResult, error) {
 	e.logger.Debug("eth_getProof", "address", address.Hex(), "keys", storageKeys, "block number or hash", blockNrOrHash)
 
@@ -1044,16 +1250,10 @@ func (e *PublicAPI) GetProof(address common.Address, storageKeys []string, block
 			return nil, err
 		}
 
-		// check for proof
-		var proofStr string
-		if proof != nil {
-			proofStr = proof.String()
-		}
-
 		storageProofs[i] = rpctypes.StorageResult{
 			Key:   key,
 			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
-			Proof: []string{proofStr},
+			Proof: rpctypes.NewProofOps(proof),
 		}
 	}
 
@@ -1069,17 +1269,11 @@ func (e *PublicAPI) GetProof(address common.Address, storageKeys []string, block
 
 	// query account proofs
 	accountKey := authtypes.AddressStoreKey(sdk.AccAddress(address.Bytes()))
-	_, proof, err := e.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
+	accountBz, proof, err := e.queryClient.GetProof(clientCtx, authtypes.StoreKey, accountKey)
 	if err != nil {
 		return nil, err
 	}
 
-	// check for proof
-	var accProofStr string
-	if proof != nil {
-		accProofStr = proof.String()
-	}
-
 	balance, ok := sdk.NewIntFromString(res.Balance)
 	if !ok {
 		return nil, errors.New("invalid balance")
@@ -1087,12 +1281,14 @@ func (e *PublicAPI) GetProof(address common.Address, storageKeys []string, block
 
 	return &rpctypes.AccountResult{
 		Address:      address,
-		AccountProof: []string{accProofStr},
+		AccountProof: rpctypes.NewProofOps(proof),
+		AccountValue: accountBz,
 		Balance:      (*hexutil.Big)(balance.BigInt()),
 		CodeHash:     common.HexToHash(res.CodeHash),
 		Nonce:        hexutil.Uint64(res.Nonce),
 		StorageHash:  common.Hash{}, // NOTE: Ethermint doesn't have a storage hash. TODO: implement?
 		StorageProof: storageProofs,
+		ProofHeight:  hexutil.Uint64(height + 1),
 	}, nil
 }
 
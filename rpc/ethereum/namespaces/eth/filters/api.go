@@ -2,11 +2,14 @@ package filters
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/tharsis/ethermint/rpc/ethereum/pubsub"
 	"github.com/tharsis/ethermint/rpc/ethereum/types"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -16,6 +19,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -25,12 +29,15 @@ import (
 
 // Backend defines the methods requided by the PublicFilterAPI backend
 type Backend interface {
+	BlockNumber() (hexutil.Uint64, error)
+	GetTendermintBlockByNumber(blockNum types.BlockNumber) (*coretypes.ResultBlock, error)
 	GetBlockByNumber(blockNum types.BlockNumber, fullTx bool) (map[string]interface{}, error)
 	HeaderByNumber(blockNum types.BlockNumber) (*ethtypes.Header, error)
 	HeaderByHash(blockHash common.Hash) (*ethtypes.Header, error)
 	GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error)
 	GetLogsByNumber(blockNum types.BlockNumber) ([][]*ethtypes.Log, error)
 	BlockBloom(height *int64) (ethtypes.Bloom, error)
+	GetIndexedLogs(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, bool, error)
 
 	BloomStatus() (uint64, uint64)
 
@@ -42,6 +49,18 @@ type Backend interface {
 // consider a filter inactive if it has not been polled for within deadline
 var deadline = 5 * time.Minute
 
+// errBlockHashWithRange is returned when a filter criteria sets both a block hash and a block range, see EIP-234.
+var errBlockHashWithRange = errors.New("cannot specify both BlockHash and FromBlock/ToBlock, choose one or the other")
+
+// withoutZeroBlockHash drops a zero block hash from the filter criteria, it
+// means that no block hash is set.
+func withoutZeroBlockHash(crit filters.FilterCriteria) filters.FilterCriteria {
+	if crit.BlockHash != nil && *crit.BlockHash == (common.Hash{}) {
+		crit.BlockHash = nil
+	}
+	return crit
+}
+
 // filter is a helper struct that holds meta information over the filter type
 // and associated subscription in the event system.
 type filter struct {
@@ -50,7 +69,9 @@ type filter struct {
 	hashes   []common.Hash
 	crit     filters.FilterCriteria
 	logs     []*ethtypes.Log
-	s        *Subscription // associated subscription in event system
+	s        *Subscription // associated subscription in event system, nil for the filters polled from the chain
+	stored   *StoredFilter // persisted state of the filter, nil if the filters are not persisted
+	pollMu   sync.Mutex    // serializes the polls of a persisted filter, they read the chain without the filters lock
 }
 
 // PublicFilterAPI offers support to create and manage filters. This will allow external clients to retrieve various
@@ -62,10 +83,14 @@ type PublicFilterAPI struct {
 	events    *EventSystem
 	filtersMu sync.Mutex
 	filters   map[rpc.ID]*filter
+	store     *FilterStore
 }
 
-// NewPublicAPI returns a new PublicFilterAPI instance.
-func NewPublicAPI(logger log.Logger, clientCtx client.Context, tmWSClient *rpcclient.WSClient, backend Backend) *PublicFilterAPI {
+// NewPublicAPI returns a new PublicFilterAPI instance. If a store is given, the
+// filters are persisted and the ones of the previous runs are restored.
+func NewPublicAPI(
+	logger log.Logger, clientCtx client.Context, tmWSClient *rpcclient.WSClient, backend Backend, store *FilterStore,
+) *PublicFilterAPI {
 	logger = logger.With("api", "filter")
 	api := &PublicFilterAPI{
 		logger:    logger,
@@ -73,6 +98,11 @@ func NewPublicAPI(logger log.Logger, clientCtx client.Context, tmWSClient *rpccl
 		backend:   backend,
 		filters:   make(map[rpc.ID]*filter),
 		events:    NewEventSystem(logger, tmWSClient),
+		store:     store,
+	}
+
+	if store != nil {
+		api.restoreFilters()
 	}
 
 	go api.timeoutLoop()
@@ -92,8 +122,11 @@ func (api *PublicFilterAPI) timeoutLoop() {
 		for id, f := range api.filters {
 			select {
 			case <-f.deadline.C:
-				f.s.Unsubscribe(api.events)
+				if f.s != nil {
+					f.s.Unsubscribe(api.events)
+				}
 				delete(api.filters, id)
+				api.deleteStoredFilter(id)
 			default:
 				continue
 			}
@@ -123,57 +156,74 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 		return rpc.ID(fmt.Sprintf("error creating pending tx filter: %s", err.Error()))
 	}
 
-	api.filters[pendingTxSub.ID()] = &filter{typ: filters.PendingTransactionsSubscription, deadline: time.NewTimer(deadline), hashes: make([]common.Hash, 0), s: pendingTxSub}
+	f := &filter{typ: filters.PendingTransactionsSubscription, deadline: time.NewTimer(deadline), hashes: make([]common.Hash, 0), s: pendingTxSub}
+	if api.store != nil {
+		stored := NewStoredFilter(filters.PendingTransactionsSubscription, filters.FilterCriteria{}, 0)
+		if err := api.store.Save(pendingTxSub.ID(), stored); err != nil {
+			pendingTxSub.Unsubscribe(api.events)
+			return rpc.ID(fmt.Sprintf("error creating pending tx filter: %s", err.Error()))
+		}
+		f.stored = &stored
+	}
+	api.filters[pendingTxSub.ID()] = f
 
-	go func(txsCh <-chan coretypes.ResultEvent, errCh <-chan error) {
-		defer cancelSubs()
+	go api.collectPendingTxs(pendingTxSub.ID(), pendingTxSub, cancelSubs)
 
-		for {
-			select {
-			case ev, ok := <-txsCh:
-				if !ok {
-					api.filtersMu.Lock()
-					delete(api.filters, pendingTxSub.ID())
-					api.filtersMu.Unlock()
-					return
-				}
-
-				data, ok := ev.Data.(tmtypes.EventDataTx)
-				if !ok {
-					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
-					continue
-				}
+	return pendingTxSub.ID()
+}
 
-				tx, err := api.clientCtx.TxConfig.TxDecoder()(data.Tx)
-				if err != nil {
-					api.logger.Debug("fail to decode tx", "error", err.Error())
-					continue
-				}
+// collectPendingTxs collects the hashes of the eth txs entering the mempool
+// into the pending tx filter with the given id.
+func (api *PublicFilterAPI) collectPendingTxs(id rpc.ID, pendingTxSub *Subscription, cancelSubs pubsub.UnsubscribeFunc) {
+	defer cancelSubs()
 
+	txsCh, errCh := pendingTxSub.eventCh, pendingTxSub.Err()
+	for {
+		select {
+		case ev, ok := <-txsCh:
+			if !ok {
 				api.filtersMu.Lock()
-				if f, found := api.filters[pendingTxSub.ID()]; found {
-					for _, msg := range tx.GetMsgs() {
-						ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
-						if ok {
-							f.hashes = append(f.hashes, common.HexToHash(ethTx.Hash))
-						}
+				delete(api.filters, id)
+				api.deleteStoredFilter(id)
+				api.filtersMu.Unlock()
+				return
+			}
+
+			data, ok := ev.Data.(tmtypes.EventDataTx)
+			if !ok {
+				api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
+				continue
+			}
+
+			tx, err := api.clientCtx.TxConfig.TxDecoder()(data.Tx)
+			if err != nil {
+				api.logger.Debug("fail to decode tx", "error", err.Error())
+				continue
+			}
+
+			api.filtersMu.Lock()
+			if f, found := api.filters[id]; found {
+				for _, msg := range tx.GetMsgs() {
+					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
+					if ok {
+						f.hashes = append(f.hashes, common.HexToHash(ethTx.Hash))
 					}
 				}
-				api.filtersMu.Unlock()
-			case <-errCh:
-				api.filtersMu.Lock()
-				delete(api.filters, pendingTxSub.ID())
-				api.filtersMu.Unlock()
 			}
+			api.filtersMu.Unlock()
+		case <-errCh:
+			api.filtersMu.Lock()
+			delete(api.filters, id)
+			api.deleteStoredFilter(id)
+			api.filtersMu.Unlock()
 		}
-	}(pendingTxSub.eventCh, pendingTxSub.Err())
-
-	return pendingTxSub.ID()
+	}
 }
 
 // NewPendingTransactions creates a subscription that is triggered each time a transaction
 // enters the transaction pool and was signed from one of the transactions this nodes manages.
-func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+// The full transactions are notified instead of the hashes if fullTx is true.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
@@ -218,9 +268,16 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 
 				for _, msg := range tx.GetMsgs() {
 					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
-					if ok {
-						_ = notifier.Notify(rpcSub.ID, common.HexToHash(ethTx.Hash))
+					if !ok {
+						continue
 					}
+
+					result, err := PendingTxResult(ethTx, fullTx != nil && *fullTx)
+					if err != nil {
+						api.logger.Debug("failed to format pending tx", "hash", ethTx.Hash, "error", err.Error())
+						continue
+					}
+					_ = notifier.Notify(rpcSub.ID, result)
 				}
 			case <-rpcSub.Err():
 				pendingTxSub.Unsubscribe(api.events)
@@ -247,6 +304,15 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 		return rpc.ID("error creating block filter: max limit reached")
 	}
 
+	if api.store != nil {
+		id, err := api.newStoredFilter(filters.BlocksSubscription, filters.FilterCriteria{})
+		if err != nil {
+			// wrap error on the ID
+			return rpc.ID(fmt.Sprintf("error creating block filter: %s", err.Error()))
+		}
+		return id
+	}
+
 	headerSub, cancelSubs, err := api.events.SubscribeNewHeads()
 	if err != nil {
 		// wrap error on the ID
@@ -420,8 +486,23 @@ func (api *PublicFilterAPI) Logs(ctx context.Context, crit filters.FilterCriteri
 //
 // In case "fromBlock" > "toBlock" an error is returned.
 //
+// A filter on a block hash fails if the block is unknown, its logs are retrieved
+// with eth_getFilterLogs and eth_getFilterChanges doesn't return any log since
+// the block is final. A zero block hash is ignored.
+//
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_newfilter
 func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID, error) {
+	criteria = withoutZeroBlockHash(criteria)
+	if criteria.BlockHash != nil {
+		if criteria.FromBlock != nil || criteria.ToBlock != nil {
+			return rpc.ID(""), errBlockHashWithRange
+		}
+		// fails on unknown blocks and caches the block logs for eth_getFilterLogs
+		if _, err := api.backend.GetLogs(*criteria.BlockHash); err != nil {
+			return rpc.ID(""), err
+		}
+	}
+
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
@@ -429,6 +510,10 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 		return rpc.ID(""), fmt.Errorf("error creating filter: max limit reached")
 	}
 
+	if api.store != nil {
+		return api.newStoredFilter(filters.LogsSubscription, criteria)
+	}
+
 	var (
 		filterID = rpc.ID("")
 		err      error
@@ -461,6 +546,11 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 					continue
 				}
 
+				// the new logs are never part of the filtered block
+				if criteria.BlockHash != nil {
+					continue
+				}
+
 				txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
 				if err != nil {
 					return
@@ -486,11 +576,16 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 }
 
 // GetLogs returns logs matching the given argument that are stored within the state.
+// A zero block hash is ignored.
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
 func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
+	crit = withoutZeroBlockHash(crit)
 	var filter *Filter
 	if crit.BlockHash != nil {
+		if crit.FromBlock != nil || crit.ToBlock != nil {
+			return nil, errBlockHashWithRange
+		}
 		// Block filter requested, construct a single-shot filter
 		filter = NewBlockFilter(api.logger, api.backend, crit)
 	} else {
@@ -524,13 +619,16 @@ func (api *PublicFilterAPI) UninstallFilter(id rpc.ID) bool {
 	f, found := api.filters[id]
 	if found {
 		delete(api.filters, id)
+		api.deleteStoredFilter(id)
 	}
 	api.filtersMu.Unlock()
 
 	if !found {
 		return false
 	}
-	f.s.Unsubscribe(api.events)
+	if f.s != nil {
+		f.s.Unsubscribe(api.events)
+	}
 	return true
 }
 
@@ -585,10 +683,10 @@ func (api *PublicFilterAPI) GetFilterLogs(ctx context.Context, id rpc.ID) ([]*et
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getfilterchanges
 func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 	api.filtersMu.Lock()
-	defer api.filtersMu.Unlock()
 
 	f, found := api.filters[id]
 	if !found {
+		api.filtersMu.Unlock()
 		return nil, fmt.Errorf("filter %s not found", id)
 	}
 
@@ -599,6 +697,12 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 	}
 	f.deadline.Reset(deadline)
 
+	if f.s == nil {
+		api.filtersMu.Unlock()
+		return api.pollStoredFilter(id, f)
+	}
+	defer api.filtersMu.Unlock()
+
 	switch f.typ {
 	case filters.PendingTransactionsSubscription, filters.BlocksSubscription:
 		hashes := f.hashes
@@ -613,3 +717,181 @@ func (api *PublicFilterAPI) GetFilterChanges(id rpc.ID) (interface{}, error) {
 		return nil, fmt.Errorf("invalid filter %s type %d", id, f.typ)
 	}
 }
+
+// newStoredFilter creates a persisted block or log filter. Its changes are
+// collected from the chain by height when it's polled instead of from the
+// events, so they resume without gap after a node restart. The caller must
+// hold the filters lock.
+func (api *PublicFilterAPI) newStoredFilter(typ filters.Type, criteria filters.FilterCriteria) (rpc.ID, error) {
+	height, err := api.backend.BlockNumber()
+	if err != nil {
+		return rpc.ID(""), err
+	}
+
+	id := rpc.NewID()
+	stored := NewStoredFilter(typ, criteria, int64(height))
+	if err := api.store.Save(id, stored); err != nil {
+		return rpc.ID(""), err
+	}
+
+	api.filters[id] = &filter{typ: typ, crit: criteria, deadline: time.NewTimer(deadline), hashes: []common.Hash{}, stored: &stored}
+	return id, nil
+}
+
+// pollStoredFilter returns the changes of a persisted block or log filter in the
+// blocks after the last polled height, up to the block range cap per poll, and
+// records the new last polled height. The log filters stop at the last block
+// whose logs fit in the logs cap. The caller must not hold the filters lock,
+// the chain is read without it.
+func (api *PublicFilterAPI) pollStoredFilter(id rpc.ID, f *filter) (interface{}, error) {
+	f.pollMu.Lock()
+	defer f.pollMu.Unlock()
+
+	latest, err := api.backend.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	from := f.stored.LastHeight + 1
+	to := int64(latest)
+	if blockRangeCap := int64(api.backend.RPCBlockRangeCap()); to-from >= blockRangeCap {
+		// the next blocks are returned by the next poll
+		to = from + blockRangeCap - 1
+	}
+
+	var changes interface{}
+	switch f.typ {
+	case filters.BlocksSubscription:
+		hashes := []common.Hash{}
+		for height := from; height <= to; height++ {
+			resBlock, err := api.backend.GetTendermintBlockByNumber(types.BlockNumber(height))
+			if err != nil {
+				return nil, err
+			}
+			if resBlock == nil || resBlock.Block == nil {
+				return nil, fmt.Errorf("block not found for height %d", height)
+			}
+			hashes = append(hashes, common.BytesToHash(resBlock.Block.Hash()))
+		}
+		changes = hashes
+	case filters.LogsSubscription:
+		logs, last, err := api.storedFilterLogs(f.crit, from, to)
+		if err != nil {
+			return nil, err
+		}
+		to = last
+		changes = returnLogs(logs)
+	default:
+		return nil, fmt.Errorf("invalid filter %s type %d", id, f.typ)
+	}
+
+	if to < from {
+		return changes, nil
+	}
+
+	api.filtersMu.Lock()
+	defer api.filtersMu.Unlock()
+
+	// the filter was uninstalled or timed out during the poll
+	if api.filters[id] != f {
+		return changes, nil
+	}
+
+	stored := *f.stored
+	stored.LastHeight = to
+	if err := api.store.Save(id, stored); err != nil {
+		return nil, err
+	}
+	f.stored = &stored
+	return changes, nil
+}
+
+// storedFilterLogs returns the logs matching the criteria of a persisted log
+// filter in the [from, to] blocks and the last height whose logs are returned.
+// The range is halved while the query fails so that its logs fit in the logs
+// cap, the logs of a single block are returned in full.
+func (api *PublicFilterAPI) storedFilterLogs(crit filters.FilterCriteria, from, to int64) ([]*ethtypes.Log, int64, error) {
+	// the logs of the filtered block are returned by eth_getFilterLogs
+	if crit.BlockHash != nil {
+		return nil, to, nil
+	}
+
+	// the negative block numbers are the block tags, they don't bound the range
+	last := to
+	if crit.FromBlock != nil && crit.FromBlock.Int64() > from {
+		from = crit.FromBlock.Int64()
+	}
+	if crit.ToBlock != nil && crit.ToBlock.Int64() >= 0 && crit.ToBlock.Int64() < to {
+		to = crit.ToBlock.Int64()
+	}
+	if from > to {
+		return nil, last, nil
+	}
+
+	end := to
+	logsCap := int(api.backend.RPCLogsCap())
+	blockRangeCap := int64(api.backend.RPCBlockRangeCap())
+	for {
+		limit := logsCap
+		if from == to {
+			limit = math.MaxInt32
+		}
+
+		filter := NewRangeFilter(api.logger, api.backend, from, to, crit.Addresses, crit.Topics)
+		logs, err := filter.Logs(context.Background(), limit, blockRangeCap)
+		switch {
+		case err == nil && to < end:
+			return logs, to, nil
+		case err == nil:
+			// the blocks after the criteria range are polled too
+			return logs, last, nil
+		case from == to:
+			return nil, 0, err
+		}
+
+		// the next blocks are returned by the next poll
+		to = from + (to-from)/2
+	}
+}
+
+// restoreFilters restores the filters persisted by the previous runs, their
+// deadline starts over. The pending tx filters only collect the txs entering
+// the mempool after the restart.
+func (api *PublicFilterAPI) restoreFilters() {
+	stored, err := api.store.Load()
+	if err != nil {
+		api.logger.Error("failed to load the persisted filters", "error", err.Error())
+		return
+	}
+
+	api.filtersMu.Lock()
+	defer api.filtersMu.Unlock()
+
+	for id, sf := range stored {
+		sf := sf
+		f := &filter{typ: sf.Type, crit: sf.Criteria(), deadline: time.NewTimer(deadline), hashes: []common.Hash{}, stored: &sf}
+
+		if sf.Type == filters.PendingTransactionsSubscription {
+			pendingTxSub, cancelSubs, err := api.events.SubscribePendingTxs()
+			if err != nil {
+				api.logger.Error("failed to restore pending tx filter", "id", id, "error", err.Error())
+				continue
+			}
+			f.s = pendingTxSub
+			go api.collectPendingTxs(id, pendingTxSub, cancelSubs)
+		}
+
+		api.filters[id] = f
+	}
+}
+
+// deleteStoredFilter removes the filter from the store if the filters are
+// persisted.
+func (api *PublicFilterAPI) deleteStoredFilter(id rpc.ID) {
+	if api.store == nil {
+		return
+	}
+	if err := api.store.Delete(id); err != nil {
+		api.logger.Error("failed to delete persisted filter", "id", id, "error", err.Error())
+	}
+}
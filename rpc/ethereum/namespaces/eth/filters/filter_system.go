@@ -32,6 +32,16 @@ var (
 	headerEvents = tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String()
 )
 
+// CosmosEventsSubscription is the type of the subscriptions to the Tendermint events matching an arbitrary query,
+// it follows the geth subscription types.
+const CosmosEventsSubscription = filters.LastIndexSubscription
+
+// IsEthEventsQuery returns true if the normalized query is the query of the eth subscriptions, the subscriptions
+// to the same query share a topic.
+func IsEthEventsQuery(query string) bool {
+	return query == txEvents || query == evmEvents || query == headerEvents
+}
+
 // EventSystem creates subscriptions, processes events and broadcasts them to the
 // subscription which match the subscription criteria using the Tendermint's RPC client.
 type EventSystem struct {
@@ -44,7 +54,10 @@ type EventSystem struct {
 
 	index      filterIndex
 	topicChans map[string]chan<- coretypes.ResultEvent
-	indexMux   *sync.RWMutex
+	// topicRefs counts the installed subscriptions of each topic, the subscriptions of different types may
+	// share a topic and it's removed along with its last subscription.
+	topicRefs map[string]int
+	indexMux  *sync.RWMutex
 
 	// Channels
 	install   chan *Subscription // install filter for event notification
@@ -60,7 +73,7 @@ type EventSystem struct {
 // or by stopping the given mux.
 func NewEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient) *EventSystem {
 	index := make(filterIndex)
-	for i := filters.UnknownSubscription; i < filters.LastIndexSubscription; i++ {
+	for i := filters.UnknownSubscription; i <= CosmosEventsSubscription; i++ {
 		index[i] = make(map[rpc.ID]*Subscription)
 	}
 
@@ -71,6 +84,7 @@ func NewEventSystem(logger log.Logger, tmWSClient *rpcclient.WSClient) *EventSys
 		lightMode:  false,
 		index:      index,
 		topicChans: make(map[string]chan<- coretypes.ResultEvent, len(index)),
+		topicRefs:  make(map[string]int, len(index)),
 		indexMux:   new(sync.RWMutex),
 		install:    make(chan *Subscription),
 		uninstall:  make(chan *Subscription),
@@ -99,18 +113,9 @@ func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.Unsub
 	ctx, cancelFn := context.WithCancel(context.Background())
 	defer cancelFn()
 
-	existingSubs := es.eventBus.Topics()
-	for _, topic := range existingSubs {
-		if topic == sub.event {
-			eventCh, unsubFn, err := es.eventBus.Subscribe(sub.event)
-			if err != nil {
-				err := errors.Wrapf(err, "failed to subscribe to topic: %s", sub.event)
-				return nil, nil, err
-			}
-
-			sub.eventCh = eventCh
-			return sub, unsubFn, nil
-		}
+	// the subscriptions to an installed topic share its Tendermint subscription
+	if sub, unsubFn, ok, err := es.subscribeInstalled(sub); ok {
+		return sub, unsubFn, err
 	}
 
 	switch sub.typ {
@@ -120,6 +125,8 @@ func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.Unsub
 		err = es.tmWSClient.Subscribe(ctx, sub.event)
 	case filters.PendingTransactionsSubscription:
 		err = es.tmWSClient.Subscribe(ctx, sub.event)
+	case CosmosEventsSubscription:
+		err = es.tmWSClient.Subscribe(ctx, sub.event)
 	default:
 		err = fmt.Errorf("invalid filter subscription type %d", sub.typ)
 	}
@@ -142,6 +149,29 @@ func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.Unsub
 	return sub, unsubFn, nil
 }
 
+// subscribeInstalled subscribes to the topic of the subscription if it's already installed, it returns false
+// otherwise.
+func (es *EventSystem) subscribeInstalled(sub *Subscription) (*Subscription, pubsub.UnsubscribeFunc, bool, error) {
+	es.indexMux.Lock()
+	defer es.indexMux.Unlock()
+
+	if _, ok := es.topicChans[sub.event]; !ok {
+		return nil, nil, false, nil
+	}
+
+	eventCh, unsubFn, err := es.eventBus.Subscribe(sub.event)
+	if err != nil {
+		return nil, nil, true, errors.Wrapf(err, "failed to subscribe to topic: %s", sub.event)
+	}
+
+	es.index[sub.typ][sub.id] = sub
+	es.topicRefs[sub.event]++
+	close(sub.installed)
+
+	sub.eventCh = eventCh
+	return sub, unsubFn, true, nil
+}
+
 // SubscribeLogs creates a subscription that will write all logs matching the
 // given criteria to the given logs channel. Default value for the from and to
 // block is "latest". If the fromBlock > toBlock an error is returned.
@@ -158,6 +188,14 @@ func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription
 		to = rpc.BlockNumber(crit.ToBlock.Int64())
 	}
 
+	// the safe and finalized blocks are the latest one with the instant finality
+	if from == rpc.SafeBlockNumber || from == rpc.FinalizedBlockNumber {
+		from = rpc.LatestBlockNumber
+	}
+	if to == rpc.SafeBlockNumber || to == rpc.FinalizedBlockNumber {
+		to = rpc.LatestBlockNumber
+	}
+
 	switch {
 	// only interested in new mined logs, mined logs within a specific block range, or
 	// logs from a specific block number to new mined blocks
@@ -215,6 +253,25 @@ func (es EventSystem) SubscribePendingTxs() (*Subscription, pubsub.UnsubscribeFu
 	return es.subscribe(sub)
 }
 
+// SubscribeCosmosEvents subscribes to the Tendermint events matching the query, the subscriptions to the same
+// query share a single subscription of the Tendermint websocket client.
+func (es *EventSystem) SubscribeCosmosEvents(query string) (*Subscription, pubsub.UnsubscribeFunc, error) {
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "invalid query %s", query)
+	}
+
+	sub := &Subscription{
+		id:        rpc.NewID(),
+		typ:       CosmosEventsSubscription,
+		event:     q.String(),
+		created:   time.Now().UTC(),
+		installed: make(chan struct{}, 1),
+		err:       make(chan error, 1),
+	}
+	return es.subscribe(sub)
+}
+
 type filterIndex map[filters.Type]map[rpc.ID]*Subscription
 
 // eventLoop (un)installs filters and processes mux events.
@@ -224,27 +281,26 @@ func (es *EventSystem) eventLoop() {
 		case f := <-es.install:
 			es.indexMux.Lock()
 			es.index[f.typ][f.id] = f
-			ch := make(chan coretypes.ResultEvent)
-			es.topicChans[f.event] = ch
-			if err := es.eventBus.AddTopic(f.event, ch); err != nil {
-				es.logger.Error("failed to add event topic to event bus", "topic", f.event, "error", err.Error())
+			if _, ok := es.topicChans[f.event]; !ok {
+				ch := make(chan coretypes.ResultEvent)
+				es.topicChans[f.event] = ch
+				if err := es.eventBus.AddTopic(f.event, ch); err != nil {
+					es.logger.Error("failed to add event topic to event bus", "topic", f.event, "error", err.Error())
+				}
 			}
+			es.topicRefs[f.event]++
 			es.indexMux.Unlock()
 			close(f.installed)
 		case f := <-es.uninstall:
 			es.indexMux.Lock()
-			delete(es.index[f.typ], f.id)
-
-			var channelInUse bool
-			for _, sub := range es.index[f.typ] {
-				if sub.event == f.event {
-					channelInUse = true
-					break
-				}
+			if _, ok := es.index[f.typ][f.id]; ok {
+				delete(es.index[f.typ], f.id)
+				es.topicRefs[f.event]--
 			}
 
 			// remove topic only when channel is not used by other subscriptions
-			if !channelInUse {
+			if es.topicRefs[f.event] <= 0 {
+				delete(es.topicRefs, f.event)
 				if err := es.tmWSClient.Unsubscribe(es.ctx, f.event); err != nil {
 					es.logger.Error("failed to unsubscribe from query", "query", f.event, "error", err.Error())
 				}
@@ -0,0 +1,132 @@
+package filters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// tmEventsServer is a Tendermint websocket endpoint recording the methods of the requests.
+type tmEventsServer struct {
+	mu      sync.Mutex
+	methods []string
+}
+
+func (s *tmEventsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	for {
+		var req struct {
+			Method string `json:"method"`
+		}
+		if err := conn.ReadJSON(&req); err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.methods = append(s.methods, req.Method)
+		s.mu.Unlock()
+	}
+}
+
+func (s *tmEventsServer) numRequests(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int
+	for _, m := range s.methods {
+		if m == method {
+			n++
+		}
+	}
+	return n
+}
+
+// waitUninstalled unsubscribes the subscription from the event system and waits for its removal.
+func waitUninstalled(t *testing.T, es *EventSystem, sub *Subscription) {
+	sub.Unsubscribe(es)
+	select {
+	case _, ok := <-sub.Err():
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscription not uninstalled")
+	}
+}
+
+func TestSubscriptionsShareTopic(t *testing.T) {
+	tmServer := &tmEventsServer{}
+	srv := httptest.NewServer(tmServer)
+	defer srv.Close()
+
+	tmWSClient, err := rpcclient.NewWS(srv.URL, "/websocket")
+	require.NoError(t, err)
+	require.NoError(t, tmWSClient.Start())
+	defer tmWSClient.Stop() //nolint:errcheck
+
+	es := NewEventSystem(log.NewNopLogger(), tmWSClient)
+
+	// the cosmos events query of the new blocks installs the topic of the newHeads subscriptions
+	cosmosSub, cosmosUnsub, err := es.SubscribeCosmosEvents("tm.event='NewBlockHeader'")
+	require.NoError(t, err)
+	require.Equal(t, headerEvents, cosmosSub.event)
+
+	headersSub, headersUnsub, err := es.SubscribeNewHeads()
+	require.NoError(t, err)
+
+	cosmosUnsub()
+	waitUninstalled(t, es, cosmosSub)
+
+	es.indexMux.RLock()
+	topicCh, found := es.topicChans[headerEvents]
+	refs := es.topicRefs[headerEvents]
+	es.indexMux.RUnlock()
+	require.True(t, found)
+	require.Equal(t, 1, refs)
+
+	// the newHeads subscription still receives the headers
+	received := make(chan coretypes.ResultEvent, 1)
+	go func() {
+		if ev, ok := <-headersSub.Event(); ok {
+			received <- ev
+		}
+	}()
+
+	ev := coretypes.ResultEvent{
+		Query: headerEvents,
+		Data:  tmtypes.EventDataNewBlockHeader{Header: tmtypes.Header{Height: 1}},
+	}
+	require.Eventually(t, func() bool {
+		topicCh <- ev
+		select {
+		case <-received:
+			return true
+		default:
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+
+	// the topic is removed along with its last subscription
+	headersUnsub()
+	waitUninstalled(t, es, headersSub)
+
+	es.indexMux.RLock()
+	_, found = es.topicChans[headerEvents]
+	es.indexMux.RUnlock()
+	require.False(t, found)
+
+	require.Eventually(t, func() bool { return tmServer.numRequests("unsubscribe") == 1 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, 1, tmServer.numRequests("subscribe"))
+}
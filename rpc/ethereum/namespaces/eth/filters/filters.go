@@ -95,16 +95,7 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 
 	// If we're doing singleton block filtering, execute and return
 	if f.criteria.BlockHash != nil && *f.criteria.BlockHash != (common.Hash{}) {
-		header, err := f.backend.HeaderByHash(*f.criteria.BlockHash)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to fetch header by hash")
-		}
-
-		if header == nil {
-			return nil, errors.Errorf("unknown block header %s", f.criteria.BlockHash.String())
-		}
-
-		return f.blockLogs(header.Number.Int64(), header.Bloom)
+		return f.blockLogsByHash(*f.criteria.BlockHash, logLimit)
 	}
 
 	// Figure out the limits of the filter range
@@ -144,6 +135,19 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	from := f.criteria.FromBlock.Int64()
 	to := f.criteria.ToBlock.Int64()
 
+	// serve the range from the log index when it's covered, the blocks after the head don't have logs
+	indexedTo := to
+	if indexedTo > head {
+		indexedTo = head
+	}
+	indexedLogs, ok, err := f.backend.GetIndexedLogs(from, indexedTo, f.criteria.Addresses, f.criteria.Topics, logLimit)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return indexedLogs, nil
+	}
+
 	for height := from; height <= to; height++ {
 		bloom, err := f.backend.BlockBloom(&height)
 		if err != nil {
@@ -164,6 +168,26 @@ func (f *Filter) Logs(_ context.Context, logLimit int, blockLimit int64) ([]*eth
 	return logs, nil
 }
 
+// blockLogsByHash returns the logs matching the filter criteria within the block with the given hash, it fails
+// if the block is unknown as required by EIP-234.
+func (f *Filter) blockLogsByHash(hash common.Hash, logLimit int) ([]*ethtypes.Log, error) {
+	logsList, err := f.backend.GetLogs(hash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch logs of block %s", hash.Hex())
+	}
+
+	unfiltered := make([]*ethtypes.Log, 0)
+	for _, logs := range logsList {
+		unfiltered = append(unfiltered, logs...)
+	}
+
+	logs := FilterLogs(unfiltered, nil, nil, f.criteria.Addresses, f.criteria.Topics)
+	if len(logs) > logLimit {
+		return nil, errors.Errorf("query returned more than %d results", logLimit)
+	}
+	return logs, nil
+}
+
 // blockLogs returns the logs matching the filter criteria within a single block.
 func (f *Filter) blockLogs(height int64, bloom ethtypes.Bloom) ([]*ethtypes.Log, error) {
 	if !bloomFilter(bloom, f.criteria.Addresses, f.criteria.Topics) {
@@ -0,0 +1,213 @@
+package filters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/tharsis/ethermint/rpc/ethereum/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// testBackend serves the logs of the blocks in memory, the block hashes are
+// the heights.
+type testBackend struct {
+	head    int64
+	blocks  map[int64][][]*ethtypes.Log
+	logsCap int32
+}
+
+var _ Backend = (*testBackend)(nil)
+
+func (b *testBackend) BlockNumber() (hexutil.Uint64, error) {
+	return hexutil.Uint64(b.head), nil
+}
+
+func (b *testBackend) GetTendermintBlockByNumber(types.BlockNumber) (*coretypes.ResultBlock, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *testBackend) GetBlockByNumber(types.BlockNumber, bool) (map[string]interface{}, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *testBackend) HeaderByNumber(blockNum types.BlockNumber) (*ethtypes.Header, error) {
+	height := blockNum.Int64()
+	if blockNum == types.EthLatestBlockNumber {
+		height = b.head
+	}
+	return &ethtypes.Header{Number: big.NewInt(height)}, nil
+}
+
+func (b *testBackend) HeaderByHash(common.Hash) (*ethtypes.Header, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (b *testBackend) GetLogs(blockHash common.Hash) ([][]*ethtypes.Log, error) {
+	logs, ok := b.blocks[blockHash.Big().Int64()]
+	if !ok {
+		return nil, errors.New("unknown block")
+	}
+	return logs, nil
+}
+
+func (b *testBackend) GetLogsByNumber(blockNum types.BlockNumber) ([][]*ethtypes.Log, error) {
+	return b.blocks[blockNum.Int64()], nil
+}
+
+func (b *testBackend) BlockBloom(height *int64) (ethtypes.Bloom, error) {
+	var logs []*ethtypes.Log
+	for _, txLogs := range b.blocks[*height] {
+		logs = append(logs, txLogs...)
+	}
+	return ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)), nil
+}
+
+func (b *testBackend) GetIndexedLogs(int64, int64, []common.Address, [][]common.Hash, int) ([]*ethtypes.Log, bool, error) {
+	return nil, false, nil
+}
+
+func (b *testBackend) BloomStatus() (uint64, uint64) { return 0, 0 }
+
+func (b *testBackend) RPCFilterCap() int32 { return 10 }
+
+func (b *testBackend) RPCLogsCap() int32 { return b.logsCap }
+
+func (b *testBackend) RPCBlockRangeCap() int32 { return 10 }
+
+func newTestAPI(backend Backend) *PublicFilterAPI {
+	return &PublicFilterAPI{
+		logger:  log.NewNopLogger(),
+		backend: backend,
+		filters: make(map[rpc.ID]*filter),
+	}
+}
+
+func TestGetLogsByBlockHash(t *testing.T) {
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr2 := common.BigToAddress(big.NewInt(2))
+	log1 := &ethtypes.Log{Address: addr1, BlockNumber: 2}
+	log2 := &ethtypes.Log{Address: addr2, BlockNumber: 2}
+	log3 := &ethtypes.Log{Address: addr1, BlockNumber: 3}
+
+	api := newTestAPI(&testBackend{
+		head: 3,
+		blocks: map[int64][][]*ethtypes.Log{
+			2: {{log1}, {log2}},
+			3: {{log3}},
+		},
+		logsCap: 10,
+	})
+
+	hash := common.BigToHash(big.NewInt(2))
+	unknown := common.BigToHash(big.NewInt(4))
+	zero := common.Hash{}
+
+	testCases := []struct {
+		name    string
+		crit    filters.FilterCriteria
+		expLogs []*ethtypes.Log
+		expErr  bool
+	}{
+		{
+			"block hash",
+			filters.FilterCriteria{BlockHash: &hash},
+			[]*ethtypes.Log{log1, log2},
+			false,
+		},
+		{
+			"block hash and address",
+			filters.FilterCriteria{BlockHash: &hash, Addresses: []common.Address{addr2}},
+			[]*ethtypes.Log{log2},
+			false,
+		},
+		{
+			"block hash and from block",
+			filters.FilterCriteria{BlockHash: &hash, FromBlock: big.NewInt(1)},
+			nil,
+			true,
+		},
+		{
+			"block hash and to block",
+			filters.FilterCriteria{BlockHash: &hash, ToBlock: big.NewInt(3)},
+			nil,
+			true,
+		},
+		{
+			"unknown block hash",
+			filters.FilterCriteria{BlockHash: &unknown},
+			nil,
+			true,
+		},
+		{
+			"zero block hash is ignored",
+			filters.FilterCriteria{BlockHash: &zero, FromBlock: big.NewInt(3), ToBlock: big.NewInt(3)},
+			[]*ethtypes.Log{log3},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := api.GetLogs(context.Background(), tc.crit)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expLogs, logs)
+		})
+	}
+}
+
+func TestNewFilterByBlockHash(t *testing.T) {
+	api := newTestAPI(&testBackend{head: 1, blocks: map[int64][][]*ethtypes.Log{1: {}}, logsCap: 10})
+
+	hash := common.BigToHash(big.NewInt(1))
+	unknown := common.BigToHash(big.NewInt(2))
+
+	_, err := api.NewFilter(filters.FilterCriteria{BlockHash: &hash, FromBlock: big.NewInt(1)})
+	require.ErrorIs(t, err, errBlockHashWithRange)
+
+	_, err = api.NewFilter(filters.FilterCriteria{BlockHash: &unknown})
+	require.Error(t, err)
+	require.Empty(t, api.filters)
+}
+
+func TestPendingTxResult(t *testing.T) {
+	to := common.BigToAddress(big.NewInt(1))
+	ethTx := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, ethTx.FromEthereumTx(ethtypes.NewTransaction(1, to, big.NewInt(1), 21000, big.NewInt(1), nil)))
+
+	result, err := PendingTxResult(ethTx, false)
+	require.NoError(t, err)
+	require.Equal(t, common.HexToHash(ethTx.Hash), result)
+
+	result, err = PendingTxResult(ethTx, true)
+	require.NoError(t, err)
+	bz, err := json.Marshal(result)
+	require.NoError(t, err)
+
+	var fields map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &fields))
+	require.Equal(t, ethTx.Hash, fields["hash"])
+	require.Equal(t, "0x1", fields["nonce"])
+	require.Equal(t, to.Hex(), common.HexToAddress(fields["to"].(string)).Hex())
+	// the block fields of the pending txs are null
+	for _, field := range []string{"blockHash", "blockNumber", "transactionIndex"} {
+		value, ok := fields[field]
+		require.True(t, ok, field)
+		require.Nil(t, value, field)
+	}
+}
@@ -0,0 +1,99 @@
+package filters
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// KeyPrefixFilter is the prefix of the filter id -> StoredFilter entries
+const KeyPrefixFilter = 1
+
+// StoredFilter is a polling filter persisted in the FilterStore.
+type StoredFilter struct {
+	Type      filters.Type     `json:"type"`
+	BlockHash *common.Hash     `json:"blockHash,omitempty"`
+	FromBlock *big.Int         `json:"fromBlock,omitempty"`
+	ToBlock   *big.Int         `json:"toBlock,omitempty"`
+	Addresses []common.Address `json:"addresses,omitempty"`
+	Topics    [][]common.Hash  `json:"topics,omitempty"`
+	// LastHeight is the last block height whose changes were returned by eth_getFilterChanges, the block and log
+	// filters resume from the next height.
+	LastHeight int64 `json:"lastHeight"`
+}
+
+// Criteria returns the log filter criteria of the stored filter.
+func (sf StoredFilter) Criteria() filters.FilterCriteria {
+	return filters.FilterCriteria{
+		BlockHash: sf.BlockHash,
+		FromBlock: sf.FromBlock,
+		ToBlock:   sf.ToBlock,
+		Addresses: sf.Addresses,
+		Topics:    sf.Topics,
+	}
+}
+
+// NewStoredFilter creates the StoredFilter of a filter of the given type and criteria.
+func NewStoredFilter(typ filters.Type, crit filters.FilterCriteria, lastHeight int64) StoredFilter {
+	return StoredFilter{
+		Type:       typ,
+		BlockHash:  crit.BlockHash,
+		FromBlock:  crit.FromBlock,
+		ToBlock:    crit.ToBlock,
+		Addresses:  crit.Addresses,
+		Topics:     crit.Topics,
+		LastHeight: lastHeight,
+	}
+}
+
+// FilterStore persists the polling filters on a KV db so they survive the node restarts.
+type FilterStore struct {
+	db dbm.DB
+}
+
+// NewFilterStore creates the FilterStore
+func NewFilterStore(db dbm.DB) *FilterStore {
+	return &FilterStore{db}
+}
+
+// Save stores the filter with the given id, it overwrites the previous version of the filter.
+func (fs *FilterStore) Save(id rpc.ID, f StoredFilter) error {
+	bz, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	return fs.db.SetSync(FilterKey(id), bz)
+}
+
+// Delete removes the filter with the given id.
+func (fs *FilterStore) Delete(id rpc.ID) error {
+	return fs.db.DeleteSync(FilterKey(id))
+}
+
+// Load returns all the stored filters by id.
+func (fs *FilterStore) Load() (map[rpc.ID]StoredFilter, error) {
+	it, err := dbm.IteratePrefix(fs.db, []byte{KeyPrefixFilter})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	result := make(map[rpc.ID]StoredFilter)
+	for ; it.Valid(); it.Next() {
+		var f StoredFilter
+		if err := json.Unmarshal(it.Value(), &f); err != nil {
+			return nil, err
+		}
+		result[rpc.ID(it.Key()[1:])] = f
+	}
+	return result, it.Error()
+}
+
+// FilterKey returns the key for db entry: `filter id -> StoredFilter`
+func FilterKey(id rpc.ID) []byte {
+	return append([]byte{KeyPrefixFilter}, []byte(id)...)
+}
@@ -0,0 +1,99 @@
+package filters
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestFilterStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := NewFilterStore(db)
+
+	crit := filters.FilterCriteria{
+		FromBlock: big.NewInt(10),
+		Addresses: []common.Address{common.BigToAddress(big.NewInt(1))},
+		Topics:    [][]common.Hash{nil, {common.BigToHash(big.NewInt(2))}},
+	}
+	logFilter := NewStoredFilter(filters.LogsSubscription, crit, 5)
+	blockFilter := NewStoredFilter(filters.BlocksSubscription, filters.FilterCriteria{}, 7)
+
+	require.NoError(t, store.Save(rpc.ID("0x1"), logFilter))
+	require.NoError(t, store.Save(rpc.ID("0x2"), blockFilter))
+
+	// a new store on the same db restores the filters
+	stored, err := NewFilterStore(db).Load()
+	require.NoError(t, err)
+	require.Equal(t, map[rpc.ID]StoredFilter{"0x1": logFilter, "0x2": blockFilter}, stored)
+	require.Equal(t, crit, stored["0x1"].Criteria())
+
+	// the last polled height is updated in place
+	blockFilter.LastHeight = 8
+	require.NoError(t, store.Save(rpc.ID("0x2"), blockFilter))
+	require.NoError(t, store.Delete(rpc.ID("0x1")))
+
+	stored, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, map[rpc.ID]StoredFilter{"0x2": blockFilter}, stored)
+}
+
+func TestStoredFilterChanges(t *testing.T) {
+	db := dbm.NewMemDB()
+	addr := common.BigToAddress(big.NewInt(1))
+	newLog := func(height uint64) *ethtypes.Log {
+		return &ethtypes.Log{Address: addr, BlockNumber: height}
+	}
+
+	backend := &testBackend{head: 3, blocks: map[int64][][]*ethtypes.Log{}, logsCap: 2}
+	api := newTestAPI(backend)
+	api.store = NewFilterStore(db)
+
+	id, err := api.NewFilter(filters.FilterCriteria{Addresses: []common.Address{addr}})
+	require.NoError(t, err)
+
+	// the changes start after the head at the filter creation
+	backend.blocks[3] = [][]*ethtypes.Log{{newLog(3)}}
+	backend.blocks[4] = [][]*ethtypes.Log{{newLog(4)}}
+	backend.head = 4
+	changes, err := api.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{newLog(4)}, changes)
+
+	// the changes resume after the last polled height on restart
+	backend.blocks[5] = [][]*ethtypes.Log{{newLog(5)}}
+	backend.head = 5
+	api = newTestAPI(backend)
+	api.store = NewFilterStore(db)
+	api.restoreFilters()
+
+	changes, err = api.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{newLog(5)}, changes)
+
+	// the range is shrunk to the logs cap and the next blocks are returned by the next polls
+	backend.blocks[6] = [][]*ethtypes.Log{{newLog(6), newLog(6)}}
+	backend.blocks[7] = [][]*ethtypes.Log{{newLog(7), newLog(7), newLog(7)}}
+	backend.head = 8
+	changes, err = api.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{newLog(6), newLog(6)}, changes)
+
+	// the logs of a single block are returned in full
+	changes, err = api.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{newLog(7), newLog(7), newLog(7)}, changes)
+
+	changes, err = api.GetFilterChanges(id)
+	require.NoError(t, err)
+	require.Equal(t, []*ethtypes.Log{}, changes)
+
+	stored, err := NewFilterStore(db).Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(8), stored[id].LastHeight)
+}
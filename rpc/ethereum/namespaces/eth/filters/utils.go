@@ -5,6 +5,9 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tharsis/ethermint/rpc/ethereum/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 // FilterLogs creates a slice of logs matching the given criteria.
@@ -104,3 +107,12 @@ func returnLogs(logs []*ethtypes.Log) []*ethtypes.Log {
 	}
 	return logs
 }
+
+// PendingTxResult returns the notification of a pending eth tx, the tx hash or
+// the full tx if fullTx is true. The block fields of the full tx are null.
+func PendingTxResult(ethTx *evmtypes.MsgEthereumTx, fullTx bool) (interface{}, error) {
+	if !fullTx {
+		return common.HexToHash(ethTx.Hash), nil
+	}
+	return types.NewTransactionFromMsg(ethTx, common.Hash{}, 0, 0, nil)
+}
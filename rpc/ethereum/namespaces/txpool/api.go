@@ -1,51 +1,232 @@
 package txpool
 
 import (
+	"fmt"
+
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/tharsis/ethermint/rpc/ethereum/backend"
 	"github.com/tharsis/ethermint/rpc/ethereum/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/tharsis/ethermint/issues/124
+// The pool content is decoded from the unconfirmed txs of the Tendermint mempool and the txs held by the local
+// tx queue, the eth txs of a sender are pending if their nonces follow the on-chain nonce of the sender without
+// gap, and queued otherwise. Only the first backend.MaxPendingTxs txs of the mempool are read, the content of a
+// larger mempool is truncated.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	backend backend.Backend
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, backend backend.Backend) *PublicAPI {
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		backend: backend,
 	}
 }
 
 // Content returns the transactions contained within the transaction pool
 func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTransaction, error) {
 	api.logger.Debug("txpool_content")
+	pending, queued, err := api.poolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]*types.RPCTransaction{
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = formatPoolTxs(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = formatPoolTxs(txs)
+	}
 	return content, nil
 }
 
+// ContentFrom returns the transactions contained within the transaction pool sent by the given address
+func (api *PublicAPI) ContentFrom(address common.Address) (map[string]map[string]*types.RPCTransaction, error) {
+	api.logger.Debug("txpool_contentFrom", "address", address.Hex())
+	pending, queued, err := api.poolContent(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]map[string]*types.RPCTransaction{
+		"pending": formatPoolTxs(pending[address]),
+		"queued":  formatPoolTxs(queued[address]),
+	}, nil
+}
+
 // Inspect returns the content of the transaction pool and flattens it into an
+// easily inspectable list.
 func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error) {
 	api.logger.Debug("txpool_inspect")
+	pending, queued, err := api.poolContent()
+	if err != nil {
+		return nil, err
+	}
+
 	content := map[string]map[string]map[string]string{
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+	for account, txs := range pending {
+		content["pending"][account.Hex()] = inspectPoolTxs(txs)
+	}
+	for account, txs := range queued {
+		content["queued"][account.Hex()] = inspectPoolTxs(txs)
+	}
 	return content, nil
 }
 
-// Status returns the number of pending and queued transaction in the pool.
-func (api *PublicAPI) Status() map[string]hexutil.Uint {
+// Status returns the number of pending and queued transaction in the pool. All the txs of the mempool passed
+// CheckTx and are pending, the cosmos txs included, the txs held by the local tx queue are queued.
+func (api *PublicAPI) Status() (map[string]hexutil.Uint, error) {
 	api.logger.Debug("txpool_status")
+	numPending, err := api.backend.NumPendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+	numQueued := len(api.backend.QueuedEthereumTxs())
+
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(numPending),
+		"queued":  hexutil.Uint(numQueued),
+	}, nil
+}
+
+// poolContent decodes the eth txs in the mempool, sent by one of the given senders or by anyone if none is
+// given, and groups them by sender and nonce, split into the pending and the queued ones.
+func (api *PublicAPI) poolContent(senders ...common.Address) (
+	pending, queued map[common.Address]map[uint64]*types.RPCTransaction, err error,
+) {
+	var mempoolMsgs []*evmtypes.MsgEthereumTx
+	if len(senders) > 0 {
+		mempoolMsgs, err = api.backend.PendingEthereumTxsFrom(senders...)
+	} else {
+		mempoolMsgs, err = api.pendingEthereumTxs()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	// the txs held locally until their nonce gap is filled, or replacing a mempool tx until the next block,
+	// come first so a replacement takes the nonce of the replaced tx
+	msgs := append(api.backend.QueuedEthereumTxs(senders...), mempoolMsgs...)
+
+	txsBySender := make(map[common.Address]map[uint64]*types.RPCTransaction)
+	for _, msg := range msgs {
+		rpcTx, err := types.NewTransactionFromMsg(msg, common.Hash{}, 0, 0, nil)
+		if err != nil {
+			api.logger.Debug("failed to format pool tx", "hash", msg.Hash, "error", err.Error())
+			continue
+		}
+
+		txs, ok := txsBySender[rpcTx.From]
+		if !ok {
+			txs = make(map[uint64]*types.RPCTransaction)
+			txsBySender[rpcTx.From] = txs
+		}
+		// the first tx takes the nonce
+		if _, ok := txs[uint64(rpcTx.Nonce)]; !ok {
+			txs[uint64(rpcTx.Nonce)] = rpcTx
+		}
+	}
+
+	pending = make(map[common.Address]map[uint64]*types.RPCTransaction)
+	queued = make(map[common.Address]map[uint64]*types.RPCTransaction)
+	for sender, txs := range txsBySender {
+		nonce, err := api.backend.GetTransactionCount(sender, types.EthLatestBlockNumber)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		senderPending, senderQueued := splitPoolTxs(txs, uint64(*nonce))
+		if len(senderPending) > 0 {
+			pending[sender] = senderPending
+		}
+		if len(senderQueued) > 0 {
+			queued[sender] = senderQueued
+		}
+	}
+	return pending, queued, nil
+}
+
+// pendingEthereumTxs returns the eth txs of the first backend.MaxPendingTxs txs of the mempool, in the mempool
+// order.
+func (api *PublicAPI) pendingEthereumTxs() ([]*evmtypes.MsgEthereumTx, error) {
+	txs, err := api.backend.PendingTransactions()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*evmtypes.MsgEthereumTx
+	for _, tx := range txs {
+		for _, msg := range (*tx).GetMsgs() {
+			ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+			if !ok {
+				// not valid ethereum tx
+				break
+			}
+			result = append(result, ethMsg)
+		}
+	}
+	return result, nil
+}
+
+// splitPoolTxs splits the pool txs of a sender, indexed by nonce, into the pending txs that can be executed in
+// sequence from the on-chain nonce of the sender, and the queued txs after a nonce gap. The txs with a nonce lower
+// than the on-chain one are already executed and are dropped.
+func splitPoolTxs(txs map[uint64]*types.RPCTransaction, nonce uint64) (pending, queued map[uint64]*types.RPCTransaction) {
+	pending = make(map[uint64]*types.RPCTransaction)
+	queued = make(map[uint64]*types.RPCTransaction)
+
+	next := nonce
+	for {
+		tx, ok := txs[next]
+		if !ok {
+			break
+		}
+		pending[next] = tx
+		next++
+	}
+
+	for n, tx := range txs {
+		if n > next {
+			queued[n] = tx
+		}
+	}
+	return pending, queued
+}
+
+// formatPoolTxs indexes the pool txs of a sender by the decimal nonce.
+func formatPoolTxs(txs map[uint64]*types.RPCTransaction) map[string]*types.RPCTransaction {
+	result := make(map[string]*types.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		result[fmt.Sprintf("%d", nonce)] = tx
+	}
+	return result
+}
+
+// inspectPoolTxs summarizes the pool txs of a sender by the decimal nonce.
+func inspectPoolTxs(txs map[uint64]*types.RPCTransaction) map[string]string {
+	result := make(map[string]string, len(txs))
+	for nonce, tx := range txs {
+		summary := "contract creation: "
+		if tx.To != nil {
+			summary = tx.To.Hex() + ": "
+		}
+		result[fmt.Sprintf("%d", nonce)] = fmt.Sprintf(
+			"%s%v wei + %v gas × %v wei", summary, tx.Value.ToInt(), uint64(tx.Gas), tx.GasPrice.ToInt(),
+		)
 	}
+	return result
 }
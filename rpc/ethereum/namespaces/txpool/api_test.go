@@ -0,0 +1,72 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tharsis/ethermint/rpc/ethereum/types"
+)
+
+func TestSplitPoolTxs(t *testing.T) {
+	poolTxs := func(nonces ...uint64) map[uint64]*types.RPCTransaction {
+		txs := make(map[uint64]*types.RPCTransaction, len(nonces))
+		for _, nonce := range nonces {
+			txs[nonce] = &types.RPCTransaction{Nonce: hexutil.Uint64(nonce)}
+		}
+		return txs
+	}
+
+	testCases := []struct {
+		name       string
+		txs        map[uint64]*types.RPCTransaction
+		nonce      uint64
+		expPending map[uint64]*types.RPCTransaction
+		expQueued  map[uint64]*types.RPCTransaction
+	}{
+		{
+			"empty pool",
+			poolTxs(),
+			0,
+			poolTxs(),
+			poolTxs(),
+		},
+		{
+			"sequential nonces",
+			poolTxs(3, 4, 5),
+			3,
+			poolTxs(3, 4, 5),
+			poolTxs(),
+		},
+		{
+			"nonce gap",
+			poolTxs(3, 4, 6, 8),
+			3,
+			poolTxs(3, 4),
+			poolTxs(6, 8),
+		},
+		{
+			"first nonce missing",
+			poolTxs(4, 5),
+			3,
+			poolTxs(),
+			poolTxs(4, 5),
+		},
+		{
+			"executed nonces are dropped",
+			poolTxs(1, 2, 3, 4),
+			3,
+			poolTxs(3, 4),
+			poolTxs(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pending, queued := splitPoolTxs(tc.txs, tc.nonce)
+			require.Equal(t, tc.expPending, pending)
+			require.Equal(t, tc.expQueued, queued)
+		})
+	}
+}
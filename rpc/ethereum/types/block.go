@@ -30,9 +30,11 @@ const (
 )
 
 const (
-	BlockParamEarliest = "earliest"
-	BlockParamLatest   = "latest"
-	BlockParamPending  = "pending"
+	BlockParamEarliest  = "earliest"
+	BlockParamLatest    = "latest"
+	BlockParamPending   = "pending"
+	BlockParamSafe      = "safe"
+	BlockParamFinalized = "finalized"
 )
 
 // NewBlockNumber creates a new BlockNumber instance.
@@ -58,6 +60,8 @@ func ContextWithHeight(height int64) context.Context {
 
 // UnmarshalJSON parses the given JSON fragment into a BlockNumber. It supports:
 // - "latest", "earliest" or "pending" as string arguments
+// - "safe" or "finalized" as string arguments, which are the latest block
+// because of the Tendermint instant finality
 // - the block number
 // Returned errors:
 // - an invalid block number error when the given argument isn't a known strings
@@ -72,7 +76,7 @@ func (bn *BlockNumber) UnmarshalJSON(data []byte) error {
 	case BlockParamEarliest:
 		*bn = EthEarliestBlockNumber
 		return nil
-	case BlockParamLatest:
+	case BlockParamLatest, BlockParamSafe, BlockParamFinalized:
 		*bn = EthLatestBlockNumber
 		return nil
 	case BlockParamPending:
@@ -158,7 +162,7 @@ func (bnh *BlockNumberOrHash) decodeFromString(input string) error {
 	case BlockParamEarliest:
 		bn := EthEarliestBlockNumber
 		bnh.BlockNumber = &bn
-	case BlockParamLatest:
+	case BlockParamLatest, BlockParamSafe, BlockParamFinalized:
 		bn := EthLatestBlockNumber
 		bnh.BlockNumber = &bn
 	case BlockParamPending:
@@ -0,0 +1,272 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// ParsedTx is the eth tx info parsed from the events of a cosmos tx.
+type ParsedTx struct {
+	// MsgIndex is the index of the msg in the cosmos tx
+	MsgIndex int
+	// Hash is the eth tx hash
+	Hash common.Hash
+	// EthTxIndex is the index of the eth tx in the block, -1 if it's not
+	// found in the events.
+	EthTxIndex int32
+	// GasUsed is the gas used by the eth tx
+	GasUsed uint64
+	// Failed is true if the eth tx execution has failed
+	Failed bool
+}
+
+// ParseTxResult parses the eth tx infos from the events of a cosmos tx. Batch
+// txs are supported, the returned list follows the order of the msgs in the tx.
+func ParseTxResult(result *abci.ResponseDeliverTx) ([]ParsedTx, error) {
+	var txs []ParsedTx
+	for _, event := range result.Events {
+		if event.Type != evmtypes.EventTypeEthereumTx {
+			continue
+		}
+
+		tx := ParsedTx{
+			MsgIndex:   len(txs),
+			EthTxIndex: -1,
+		}
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case evmtypes.AttributeKeyEthereumTxHash:
+				tx.Hash = common.HexToHash(string(attr.Value))
+			case evmtypes.AttributeKeyTxIndex:
+				txIndex, err := strconv.ParseInt(string(attr.Value), 10, 32)
+				if err != nil {
+					return nil, err
+				}
+				if txIndex < 0 {
+					return nil, fmt.Errorf("negative tx index: %d", txIndex)
+				}
+				tx.EthTxIndex = int32(txIndex)
+			case evmtypes.AttributeKeyTxGasUsed:
+				gasUsed, err := strconv.ParseUint(string(attr.Value), 10, 64)
+				if err != nil {
+					return nil, err
+				}
+				tx.GasUsed = gasUsed
+			case evmtypes.AttributeKeyEthereumTxFailed:
+				tx.Failed = true
+			}
+		}
+		txs = append(txs, tx)
+	}
+
+	// the gas used of a single msg tx is the one of the cosmos tx, for backward
+	// compatibility with the txs that don't emit the gas used attribute.
+	if len(txs) == 1 {
+		txs[0].GasUsed = uint64(result.GasUsed)
+	}
+
+	return txs, nil
+}
+
+// FindParsedTx returns the parsed tx with the given eth tx hash, returns nil if
+// not found.
+func FindParsedTx(txs []ParsedTx, hash common.Hash) *ParsedTx {
+	for i := range txs {
+		if txs[i].Hash == hash {
+			return &txs[i]
+		}
+	}
+	return nil
+}
+
+// FindParsedTxByEthTxIndex returns the parsed tx with the given eth tx index in
+// the block, returns nil if not found.
+func FindParsedTxByEthTxIndex(txs []ParsedTx, index int32) *ParsedTx {
+	for i := range txs {
+		if txs[i].EthTxIndex == index {
+			return &txs[i]
+		}
+	}
+	return nil
+}
+
+// EthTxResult is an eth tx of a block along with its result.
+type EthTxResult struct {
+	Msg    *evmtypes.MsgEthereumTx
+	Result ethermint.TxResult
+}
+
+// ParseBlockEthTxs parses the eth txs of a block and their results, following
+// the order of the valid eth txs in the block, aka. the transaction list returned
+// by the eth_getBlock api. The txs that can't be decoded are skipped.
+func ParseBlockEthTxs(txDecoder sdk.TxDecoder, block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) ([]EthTxResult, error) {
+	height := block.Header.Height
+
+	if len(block.Txs) != len(txResults) {
+		return nil, fmt.Errorf("block %d has %d txs but %d tx results", height, len(block.Txs), len(txResults))
+	}
+
+	var (
+		ethTxs            []EthTxResult
+		cumulativeGasUsed uint64
+	)
+	for txIndex, txBz := range block.Txs {
+		result := txResults[txIndex]
+		blockGasUsed := cumulativeGasUsed
+		cumulativeGasUsed += uint64(result.GasUsed)
+
+		// txs rejected before the msgs execution are not part of the eth block
+		if result.Code != abci.CodeTypeOK {
+			continue
+		}
+
+		tx, err := txDecoder(txBz)
+		if err != nil {
+			continue
+		}
+
+		var ethMsgs []*evmtypes.MsgEthereumTx
+		for _, msg := range tx.GetMsgs() {
+			if ethMsg, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+				ethMsgs = append(ethMsgs, ethMsg)
+			}
+		}
+		if len(ethMsgs) == 0 {
+			continue
+		}
+
+		parsedTxs, err := ParseTxResult(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the result of tx %d in block %d: %w", txIndex, height, err)
+		}
+		if len(parsedTxs) != len(ethMsgs) {
+			return nil, fmt.Errorf("tx %d in block %d has %d eth msgs but %d eth tx events", txIndex, height, len(ethMsgs), len(parsedTxs))
+		}
+
+		for msgIndex, ethMsg := range ethMsgs {
+			parsedTx := parsedTxs[msgIndex]
+			blockGasUsed += parsedTx.GasUsed
+
+			ethTxs = append(ethTxs, EthTxResult{
+				Msg: ethMsg,
+				Result: ethermint.TxResult{
+					Height:            height,
+					TxIndex:           uint32(txIndex),
+					MsgIndex:          uint32(msgIndex),
+					EthTxIndex:        int32(len(ethTxs)),
+					Failed:            parsedTx.Failed,
+					GasUsed:           parsedTx.GasUsed,
+					CumulativeGasUsed: blockGasUsed,
+				},
+			})
+		}
+	}
+
+	return ethTxs, nil
+}
+
+// CosmosEventAttribute is an attribute of a cosmos event decoded to strings.
+type CosmosEventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// CosmosEvent is an ABCI event with its attributes decoded to strings.
+type CosmosEvent struct {
+	Type       string                 `json:"type"`
+	Attributes []CosmosEventAttribute `json:"attributes"`
+}
+
+// CosmosEventsResult is the notification of the cosmosEvents subscription, it holds the
+// ABCI events of the tx or block matching the Tendermint query of the subscription.
+type CosmosEventsResult struct {
+	Query  string         `json:"query"`
+	Height hexutil.Uint64 `json:"height"`
+	// TxHash is the Tendermint hash of the tx, empty for the block events
+	TxHash string        `json:"txHash,omitempty"`
+	Events []CosmosEvent `json:"events"`
+}
+
+// NewCosmosEventsResult decodes the ABCI events of a Tendermint event. The events of the
+// txs and blocks are returned in the order they were emitted, the ones of the other
+// Tendermint events are rebuilt from the composite keys of the flattened events.
+func NewCosmosEventsResult(ev coretypes.ResultEvent) CosmosEventsResult {
+	result := CosmosEventsResult{Query: ev.Query}
+
+	switch data := ev.Data.(type) {
+	case tmtypes.EventDataTx:
+		result.Height = hexutil.Uint64(data.Height)
+		result.TxHash = fmt.Sprintf("%X", tmtypes.Tx(data.Tx).Hash())
+		result.Events = decodeABCIEvents(data.Result.Events)
+	case tmtypes.EventDataNewBlock:
+		if data.Block != nil {
+			result.Height = hexutil.Uint64(data.Block.Height)
+		}
+		result.Events = decodeABCIEvents(data.ResultBeginBlock.Events, data.ResultEndBlock.Events)
+	case tmtypes.EventDataNewBlockHeader:
+		result.Height = hexutil.Uint64(data.Header.Height)
+		result.Events = decodeABCIEvents(data.ResultBeginBlock.Events, data.ResultEndBlock.Events)
+	default:
+		result.Events = decodeCompositeEvents(ev.Events)
+	}
+
+	return result
+}
+
+// decodeABCIEvents decodes the attributes of the ABCI events to strings.
+func decodeABCIEvents(eventLists ...[]abci.Event) []CosmosEvent {
+	result := []CosmosEvent{}
+	for _, events := range eventLists {
+		for _, event := range events {
+			attrs := make([]CosmosEventAttribute, 0, len(event.Attributes))
+			for _, attr := range event.Attributes {
+				attrs = append(attrs, CosmosEventAttribute{Key: string(attr.Key), Value: string(attr.Value)})
+			}
+			result = append(result, CosmosEvent{Type: event.Type, Attributes: attrs})
+		}
+	}
+	return result
+}
+
+// decodeCompositeEvents rebuilds the events from the `type.key -> values` map of a
+// Tendermint event, sorted by composite key. The attributes of the events sharing a
+// type are merged as the map doesn't keep them apart.
+func decodeCompositeEvents(events map[string][]string) []CosmosEvent {
+	keys := make([]string, 0, len(events))
+	for key := range events {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := []CosmosEvent{}
+	indexes := make(map[string]int)
+	for _, key := range keys {
+		parts := strings.SplitN(key, ".", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		i, ok := indexes[parts[0]]
+		if !ok {
+			i = len(result)
+			indexes[parts[0]] = i
+			result = append(result, CosmosEvent{Type: parts[0]})
+		}
+		for _, value := range events[key] {
+			result[i].Attributes = append(result[i].Attributes, CosmosEventAttribute{Key: parts[1], Value: value})
+		}
+	}
+	return result
+}
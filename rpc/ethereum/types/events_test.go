@@ -0,0 +1,278 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+	"github.com/tharsis/ethermint/encoding"
+	"github.com/tharsis/ethermint/tests"
+	ethermint "github.com/tharsis/ethermint/types"
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func TestParseTxResult(t *testing.T) {
+	txHash1 := common.BigToHash(common.Big1)
+	txHash2 := common.BigToHash(common.Big2)
+
+	testCases := []struct {
+		name     string
+		response abci.ResponseDeliverTx
+		expTxs   []ParsedTx
+		expPass  bool
+	}{
+		{
+			"single msg tx, gas used of the cosmos tx",
+			abci.ResponseDeliverTx{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: "coin_received", Attributes: []abci.EventAttribute{
+						{Key: []byte("receiver"), Value: []byte("ethm12luku6uxehhak02py4rcz65zu0swh7wjun6msa")},
+					}},
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(txHash1.Hex())},
+						{Key: []byte(evmtypes.AttributeKeyTxIndex), Value: []byte("10")},
+					}},
+				},
+			},
+			[]ParsedTx{
+				{MsgIndex: 0, Hash: txHash1, EthTxIndex: 10, GasUsed: 21000},
+			},
+			true,
+		},
+		{
+			"batch tx",
+			abci.ResponseDeliverTx{
+				GasUsed: 50000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(txHash1.Hex())},
+						{Key: []byte(evmtypes.AttributeKeyTxIndex), Value: []byte("0")},
+						{Key: []byte(evmtypes.AttributeKeyTxGasUsed), Value: []byte("21000")},
+					}},
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(txHash2.Hex())},
+						{Key: []byte(evmtypes.AttributeKeyTxIndex), Value: []byte("1")},
+						{Key: []byte(evmtypes.AttributeKeyTxGasUsed), Value: []byte("29000")},
+						{Key: []byte(evmtypes.AttributeKeyEthereumTxFailed), Value: []byte("execution reverted")},
+					}},
+				},
+			},
+			[]ParsedTx{
+				{MsgIndex: 0, Hash: txHash1, EthTxIndex: 0, GasUsed: 21000},
+				{MsgIndex: 1, Hash: txHash2, EthTxIndex: 1, GasUsed: 29000, Failed: true},
+			},
+			true,
+		},
+		{
+			"tx index not emitted",
+			abci.ResponseDeliverTx{
+				GasUsed: 21000,
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(txHash1.Hex())},
+					}},
+				},
+			},
+			[]ParsedTx{
+				{MsgIndex: 0, Hash: txHash1, EthTxIndex: -1, GasUsed: 21000},
+			},
+			true,
+		},
+		{
+			"invalid gas used",
+			abci.ResponseDeliverTx{
+				Events: []abci.Event{
+					{Type: evmtypes.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(txHash1.Hex())},
+						{Key: []byte(evmtypes.AttributeKeyTxGasUsed), Value: []byte("-1")},
+					}},
+				},
+			},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txs, err := ParseTxResult(&tc.response)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expTxs, txs)
+
+			for _, tx := range tc.expTxs {
+				require.Equal(t, tx, *FindParsedTx(txs, tx.Hash))
+				require.Equal(t, tx, *FindParsedTxByEthTxIndex(txs, tx.EthTxIndex))
+			}
+		})
+	}
+}
+
+func TestParseBlockEthTxs(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	encodingConfig := encoding.MakeConfig(module.NewBasicManager())
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig)
+
+	to := common.BigToAddress(big.NewInt(1))
+	buildTx := func(nonce uint64) (tmtypes.Tx, common.Hash) {
+		tx := evmtypes.NewTx(nil, nonce, &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil)
+		tx.From = from.Hex()
+		require.NoError(t, tx.Sign(ethSigner, signer))
+
+		tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+		require.NoError(t, err)
+		txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+		require.NoError(t, err)
+		return txBz, common.HexToHash(tx.Hash)
+	}
+	txBz1, txHash1 := buildTx(0)
+	txBz2, txHash2 := buildTx(1)
+
+	ethTxEvent := func(hash common.Hash, txIndex string) abci.Event {
+		return abci.Event{
+			Type: evmtypes.EventTypeEthereumTx,
+			Attributes: []abci.EventAttribute{
+				{Key: []byte(evmtypes.AttributeKeyEthereumTxHash), Value: []byte(hash.Hex())},
+				{Key: []byte(evmtypes.AttributeKeyTxIndex), Value: []byte(txIndex)},
+			},
+		}
+	}
+
+	block := &tmtypes.Block{
+		Header: tmtypes.Header{Height: 1},
+		Data:   tmtypes.Data{Txs: []tmtypes.Tx{txBz1, []byte("invalid"), txBz1, txBz2}},
+	}
+	txResults := []*abci.ResponseDeliverTx{
+		{Code: 0, GasUsed: 21000, Events: []abci.Event{ethTxEvent(txHash1, "0")}},
+		{Code: 0, GasUsed: 1000},
+		// rejected by the ante handler, the gas is still counted in the block
+		{Code: 11, GasUsed: 5000},
+		{Code: 0, GasUsed: 21000, Events: []abci.Event{ethTxEvent(txHash2, "1")}},
+	}
+
+	ethTxs, err := ParseBlockEthTxs(clientCtx.TxConfig.TxDecoder(), block, txResults)
+	require.NoError(t, err)
+	require.Len(t, ethTxs, 2)
+
+	require.Equal(t, txHash1, common.HexToHash(ethTxs[0].Msg.Hash))
+	require.Equal(t, ethermint.TxResult{
+		Height:            1,
+		TxIndex:           0,
+		EthTxIndex:        0,
+		GasUsed:           21000,
+		CumulativeGasUsed: 21000,
+	}, ethTxs[0].Result)
+
+	require.Equal(t, txHash2, common.HexToHash(ethTxs[1].Msg.Hash))
+	require.Equal(t, ethermint.TxResult{
+		Height:            1,
+		TxIndex:           3,
+		EthTxIndex:        1,
+		GasUsed:           21000,
+		CumulativeGasUsed: 48000,
+	}, ethTxs[1].Result)
+
+	// the tx results must match the block txs
+	_, err = ParseBlockEthTxs(clientCtx.TxConfig.TxDecoder(), block, txResults[:1])
+	require.Error(t, err)
+}
+
+func TestNewCosmosEventsResult(t *testing.T) {
+	txBz := []byte("tx")
+	transfer := abci.Event{Type: "transfer", Attributes: []abci.EventAttribute{
+		{Key: []byte("recipient"), Value: []byte("ethm12luku6uxehhak02py4rcz65zu0swh7wjun6msa")},
+		{Key: []byte("amount"), Value: []byte("10aphoton")},
+	}}
+	expTransfer := CosmosEvent{Type: "transfer", Attributes: []CosmosEventAttribute{
+		{Key: "recipient", Value: "ethm12luku6uxehhak02py4rcz65zu0swh7wjun6msa"},
+		{Key: "amount", Value: "10aphoton"},
+	}}
+
+	testCases := []struct {
+		name      string
+		event     coretypes.ResultEvent
+		expResult CosmosEventsResult
+	}{
+		{
+			"tx events",
+			coretypes.ResultEvent{
+				Query: "tm.event='Tx'",
+				Data: tmtypes.EventDataTx{TxResult: abci.TxResult{
+					Height: 10,
+					Tx:     txBz,
+					Result: abci.ResponseDeliverTx{Events: []abci.Event{transfer}},
+				}},
+			},
+			CosmosEventsResult{
+				Query:  "tm.event='Tx'",
+				Height: 10,
+				TxHash: fmt.Sprintf("%X", tmtypes.Tx(txBz).Hash()),
+				Events: []CosmosEvent{expTransfer},
+			},
+		},
+		{
+			"block events",
+			coretypes.ResultEvent{
+				Query: "tm.event='NewBlockHeader'",
+				Data: tmtypes.EventDataNewBlockHeader{
+					Header:           tmtypes.Header{Height: 11},
+					ResultBeginBlock: abci.ResponseBeginBlock{Events: []abci.Event{transfer}},
+					ResultEndBlock:   abci.ResponseEndBlock{Events: []abci.Event{{Type: "complete_unbonding"}}},
+				},
+			},
+			CosmosEventsResult{
+				Query:  "tm.event='NewBlockHeader'",
+				Height: 11,
+				Events: []CosmosEvent{expTransfer, {Type: "complete_unbonding", Attributes: []CosmosEventAttribute{}}},
+			},
+		},
+		{
+			"composite events",
+			coretypes.ResultEvent{
+				Query: "tm.event='ValidatorSetUpdates'",
+				Data:  tmtypes.EventDataValidatorSetUpdates{},
+				Events: map[string][]string{
+					"tm.event":           {"ValidatorSetUpdates"},
+					"transfer.recipient": {"ethm12luku6uxehhak02py4rcz65zu0swh7wjun6msa"},
+					"transfer.amount":    {"10aphoton"},
+				},
+			},
+			CosmosEventsResult{
+				Query: "tm.event='ValidatorSetUpdates'",
+				Events: []CosmosEvent{
+					{Type: "tm", Attributes: []CosmosEventAttribute{{Key: "event", Value: "ValidatorSetUpdates"}}},
+					{Type: "transfer", Attributes: []CosmosEventAttribute{
+						{Key: "amount", Value: "10aphoton"},
+						{Key: "recipient", Value: "ethm12luku6uxehhak02py4rcz65zu0swh7wjun6msa"},
+					}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expResult, NewCosmosEventsResult(tc.event))
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+// NewProofOps converts the merkle proof ops returned by an ABCI query to the
+// json-rpc format.
+func NewProofOps(proof *tmcrypto.ProofOps) []ProofOp {
+	if proof == nil {
+		return []ProofOp{}
+	}
+
+	ops := make([]ProofOp, len(proof.Ops))
+	for i, op := range proof.Ops {
+		ops[i] = ProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		}
+	}
+	return ops
+}
+
+// ToTmProofOps converts the proof ops back to the tendermint merkle proof ops.
+func ToTmProofOps(ops []ProofOp) *tmcrypto.ProofOps {
+	proof := &tmcrypto.ProofOps{Ops: make([]tmcrypto.ProofOp, len(ops))}
+	for i, op := range ops {
+		proof.Ops[i] = tmcrypto.ProofOp{
+			Type: op.Type,
+			Key:  op.Key,
+			Data: op.Data,
+		}
+	}
+	return proof
+}
+
+// VerifyProof verifies the ICS-23 proof of a key in the given module store
+// against the app hash. An empty value verifies the absence of the key.
+func VerifyProof(appHash []byte, storeKey string, key, value []byte, ops []ProofOp) error {
+	prt := rootmulti.DefaultProofRuntime()
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeKey), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL)
+
+	if len(value) == 0 {
+		return prt.VerifyAbsence(ToTmProofOps(ops), appHash, keyPath.String())
+	}
+	return prt.VerifyValue(ToTmProofOps(ops), appHash, keyPath.String(), value)
+}
+
+// VerifyAccountProof verifies the account proof returned by eth_getProof
+// against the app hash of the header at the ProofHeight of the result, the app
+// hash committing the state of a block is only in the header of the next block.
+func VerifyAccountProof(appHash []byte, res *AccountResult) error {
+	key := authtypes.AddressStoreKey(sdk.AccAddress(res.Address.Bytes()))
+	if err := VerifyProof(appHash, authtypes.StoreKey, key, res.AccountValue, res.AccountProof); err != nil {
+		return fmt.Errorf("invalid account proof for %s: %w", res.Address.Hex(), err)
+	}
+	return nil
+}
+
+// VerifyStorageProof verifies the proof of a storage slot returned by
+// eth_getProof against the app hash of the header at the ProofHeight of the
+// account result, see VerifyAccountProof.
+func VerifyStorageProof(appHash []byte, address common.Address, res StorageResult) error {
+	key := evmtypes.StateKey(address, common.HexToHash(res.Key).Bytes())
+
+	var value []byte
+	if res.Value != nil {
+		value = common.BigToHash(res.Value.ToInt()).Bytes()
+	}
+
+	err := VerifyProof(appHash, evmtypes.StoreKey, key, value, res.Proof)
+	if err != nil && len(value) > 0 && res.Value.ToInt().Sign() == 0 {
+		// a zero value is either stored as is or not stored at all
+		err = VerifyProof(appHash, evmtypes.StoreKey, key, nil, res.Proof)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid storage proof for %s at %s: %w", address.Hex(), res.Key, err)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func TestVerifyProofs(t *testing.T) {
+	address := common.BigToAddress(big.NewInt(1))
+	accountKey := authtypes.AddressStoreKey(sdk.AccAddress(address.Bytes()))
+	accountBz := []byte("encoded account")
+
+	slotValue := common.BigToHash(big.NewInt(100))
+	slot := common.BigToHash(big.NewInt(1))
+	zeroSlot := common.BigToHash(big.NewInt(2))
+	emptySlot := common.BigToHash(big.NewInt(3))
+
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	evmKey := storetypes.NewKVStoreKey(evmtypes.StoreKey)
+	authKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	store.MountStoreWithDB(evmKey, storetypes.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(authKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	evmStore := store.GetCommitStore(evmKey).(*iavl.Store)
+	evmStore.Set(evmtypes.StateKey(address, slot.Bytes()), slotValue.Bytes())
+	evmStore.Set(evmtypes.StateKey(address, zeroSlot.Bytes()), common.Hash{}.Bytes())
+	store.GetCommitStore(authKey).(*iavl.Store).Set(accountKey, accountBz)
+	appHash := store.Commit().Hash
+
+	query := func(storeKey string, key []byte) ([]byte, []ProofOp) {
+		res := store.Query(abci.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", storeKey),
+			Data:  key,
+			Prove: true,
+		})
+		require.Zero(t, res.Code, res.Log)
+		return res.Value, NewProofOps(res.ProofOps)
+	}
+
+	storageResult := func(key common.Hash) StorageResult {
+		valueBz, proof := query(evmtypes.StoreKey, evmtypes.StateKey(address, key.Bytes()))
+		return StorageResult{
+			Key:   key.Hex(),
+			Value: (*hexutil.Big)(new(big.Int).SetBytes(valueBz)),
+			Proof: proof,
+		}
+	}
+
+	valueBz, accountProof := query(authtypes.StoreKey, accountKey)
+	accRes := &AccountResult{
+		Address:      address,
+		AccountProof: accountProof,
+		AccountValue: valueBz,
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func() error
+		expPass  bool
+	}{
+		{
+			"account",
+			func() error {
+				return VerifyAccountProof(appHash, accRes)
+			},
+			true,
+		},
+		{
+			"account, tampered value",
+			func() error {
+				res := *accRes
+				res.AccountValue = []byte("another account")
+				return VerifyAccountProof(appHash, &res)
+			},
+			false,
+		},
+		{
+			"account, wrong app hash",
+			func() error {
+				return VerifyAccountProof(common.BigToHash(big.NewInt(1)).Bytes(), accRes)
+			},
+			false,
+		},
+		{
+			"non-existent account",
+			func() error {
+				otherAddress := common.BigToAddress(big.NewInt(2))
+				valueBz, proof := query(authtypes.StoreKey, authtypes.AddressStoreKey(sdk.AccAddress(otherAddress.Bytes())))
+				return VerifyAccountProof(appHash, &AccountResult{
+					Address:      otherAddress,
+					AccountProof: proof,
+					AccountValue: valueBz,
+				})
+			},
+			true,
+		},
+		{
+			"storage slot",
+			func() error {
+				return VerifyStorageProof(appHash, address, storageResult(slot))
+			},
+			true,
+		},
+		{
+			"storage slot, tampered value",
+			func() error {
+				res := storageResult(slot)
+				res.Value = (*hexutil.Big)(big.NewInt(101))
+				return VerifyStorageProof(appHash, address, res)
+			},
+			false,
+		},
+		{
+			"storage slot, tampered key",
+			func() error {
+				res := storageResult(slot)
+				res.Key = emptySlot.Hex()
+				return VerifyStorageProof(appHash, address, res)
+			},
+			false,
+		},
+		{
+			"stored zero value",
+			func() error {
+				return VerifyStorageProof(appHash, address, storageResult(zeroSlot))
+			},
+			true,
+		},
+		{
+			"empty storage slot",
+			func() error {
+				return VerifyStorageProof(appHash, address, storageResult(emptySlot))
+			},
+			true,
+		},
+		{
+			"empty storage slot, non-zero value",
+			func() error {
+				res := storageResult(emptySlot)
+				res.Value = (*hexutil.Big)(big.NewInt(1))
+				return VerifyStorageProof(appHash, address, res)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.malleate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -1,32 +1,51 @@
 package types
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
 // internal pkg on geth.
 
-// AccountResult struct for account proof
+// AccountResult struct for account proof. The account proof proves the
+// AccountValue, aka. the encoded auth account, under the auth store.
 type AccountResult struct {
 	Address      common.Address  `json:"address"`
-	AccountProof []string        `json:"accountProof"`
+	AccountProof []ProofOp       `json:"accountProof"`
+	AccountValue hexutil.Bytes   `json:"accountValue"`
 	Balance      *hexutil.Big    `json:"balance"`
 	CodeHash     common.Hash     `json:"codeHash"`
 	Nonce        hexutil.Uint64  `json:"nonce"`
 	StorageHash  common.Hash     `json:"storageHash"`
 	StorageProof []StorageResult `json:"storageProof"`
+	// ProofHeight is the height of the block header whose app hash the proofs are verified against, the block
+	// following the queried one.
+	ProofHeight hexutil.Uint64 `json:"proofHeight"`
 }
 
 // StorageResult defines the format for storage proof return
 type StorageResult struct {
 	Key   string       `json:"key"`
 	Value *hexutil.Big `json:"value"`
-	Proof []string     `json:"proof"`
+	Proof []ProofOp    `json:"proof"`
+}
+
+// ProofOp defines the format of an ICS-23 commitment proof op, the proof ops
+// of a key are ordered from the module store up to the multistore root.
+type ProofOp struct {
+	// Type is either "ics23:iavl" for the module store or "ics23:simple" for the multistore
+	Type string `json:"type"`
+	// Key is the key proved by the op
+	Key hexutil.Bytes `json:"key"`
+	// Data is the protobuf encoded ics23.CommitmentProof
+	Data hexutil.Bytes `json:"data"`
 }
 
 // RPCTransaction represents a transaction that will serialize to the RPC representation of a transaction
@@ -52,21 +71,99 @@ type RPCTransaction struct {
 	S                *hexutil.Big         `json:"s"`
 }
 
-// StateOverride is the collection of overridden accounts.
-type StateOverride map[common.Address]OverrideAccount
-
-// OverrideAccount indicates the overriding fields of account during the execution of
-// a message call.
-// Note, state and stateDiff can't be specified at the same time. If state is
-// set, message execution will only use the data in the given state. Otherwise
-// if statDiff is set, all diff will be applied first and then execute the call
-// message.
-type OverrideAccount struct {
-	Nonce     *hexutil.Uint64              `json:"nonce"`
-	Code      *hexutil.Bytes               `json:"code"`
-	Balance   **hexutil.Big                `json:"balance"`
-	State     *map[common.Hash]common.Hash `json:"state"`
-	StateDiff *map[common.Hash]common.Hash `json:"stateDiff"`
+// AccessListResult returns an optional accesslist
+// It's the result of the `eth_createAccessList` RPC call.
+// It contains an error if the transaction itself failed.
+type AccessListResult struct {
+	AccessList *ethtypes.AccessList `json:"accessList"`
+	Error      string               `json:"error,omitempty"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// SimOpts are the options of the `eth_simulateV1` RPC call.
+type SimOpts struct {
+	BlockStateCalls []evmtypes.SimBlock `json:"blockStateCalls"`
+}
+
+// SimBlockResult is the result of the calls of a simulated block.
+type SimBlockResult struct {
+	Calls []SimCallResult `json:"calls"`
+}
+
+// SimCallResult is the result of a simulated call.
+type SimCallResult struct {
+	ReturnData hexutil.Bytes   `json:"returnData"`
+	Logs       []*ethtypes.Log `json:"logs"`
+	GasUsed    hexutil.Uint64  `json:"gasUsed"`
+	Status     hexutil.Uint64  `json:"status"`
+	Error      *SimCallError   `json:"error,omitempty"`
+}
+
+// ErrCodeVMError is the json-rpc error code of the vm errors other than the reverts.
+const ErrCodeVMError = -32015
+
+// SimCallError is the error of a failed simulated call, the data is the hex
+// encoded revert data.
+type SimCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// TraceConfig holds the extra parameters of the trace functions, the tracer config
+// is a JSON object passed as is to the tracer, e.g. {"onlyTopCall": true} for the
+// callTracer.
+type TraceConfig struct {
+	evmtypes.TraceConfig
+	TracerConfig json.RawMessage `json:"tracerConfig"`
+}
+
+// EVMTraceConfig returns the trace config of the evm trace queries, nil if the
+// config is nil.
+func (c *TraceConfig) EVMTraceConfig() *evmtypes.TraceConfig {
+	if c == nil {
+		return nil
+	}
+	cfg := c.TraceConfig
+	if len(c.TracerConfig) > 0 {
+		cfg.TracerJsonConfig = string(c.TracerConfig)
+	}
+	return &cfg
+}
+
+// TraceCallConfig is the config of debug_traceCall, it adds the state and block
+// overrides of the call to the trace config.
+type TraceCallConfig struct {
+	TraceConfig
+	StateOverrides *evmtypes.StateOverride  `json:"stateOverrides"`
+	BlockOverrides *evmtypes.BlockOverrides `json:"blockOverrides"`
+}
+
+// SyncingResult is the notification of the syncing subscription, following the geth format.
+type SyncingResult struct {
+	Syncing bool         `json:"syncing"`
+	Status  SyncProgress `json:"status"`
+}
+
+// SyncProgress is the block sync progress of the node. Tendermint doesn't expose the height
+// of the peers, the highest block is the latest block known by the node.
+type SyncProgress struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
+}
+
+// StorageRangeResult is the result of debug_storageRangeAt, following the geth format. The
+// storage entries are indexed by the keccak256 hash of their keys.
+type StorageRangeResult struct {
+	Storage map[common.Hash]StorageEntry `json:"storage"`
+	NextKey *common.Hash                 `json:"nextKey"` // nil if Storage includes the last key in the storage.
+}
+
+// StorageEntry is a storage slot of a StorageRangeResult.
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
 }
 
 type FeeHistoryResult struct {
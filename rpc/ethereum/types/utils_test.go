@@ -0,0 +1,81 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	proto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/tharsis/ethermint/x/evm/types"
+)
+
+func TestTxResponseError(t *testing.T) {
+	revertData := []byte{0xde, 0xad}
+
+	enc, err := proto.Marshal(&evmtypes.MsgEthereumTxResponse{
+		Ret:     revertData,
+		VmError: "execution reverted",
+	})
+	require.NoError(t, err)
+	txDataBz, err := proto.Marshal(&sdk.TxMsgData{
+		Data: []*sdk.MsgData{{MsgType: evmtypes.TypeMsgEthereumTx, Data: enc}},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		rsp     *sdk.TxResponse
+		expCode int
+		expData string
+	}{
+		{
+			"ante handler failure",
+			&sdk.TxResponse{
+				Codespace: sdkerrors.RootCodespace,
+				Code:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+				RawLog:    "insufficient funds",
+			},
+			0,
+			"",
+		},
+		{
+			"revert with data",
+			&sdk.TxResponse{
+				Codespace: evmtypes.ModuleName,
+				Code:      evmtypes.ErrExecutionReverted.ABCICode(),
+				Data:      hex.EncodeToString(txDataBz),
+			},
+			3,
+			"0xdead",
+		},
+		{
+			"revert without data",
+			&sdk.TxResponse{
+				Codespace: evmtypes.ModuleName,
+				Code:      evmtypes.ErrExecutionReverted.ABCICode(),
+			},
+			3,
+			"0x",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := TxResponseError(tc.rsp)
+			require.Error(t, err)
+
+			revertErr, ok := err.(*evmtypes.RevertError)
+			if tc.expCode == 0 {
+				require.False(t, ok)
+				require.True(t, sdkerrors.ErrInsufficientFunds.Is(err))
+				return
+			}
+			require.True(t, ok)
+			require.Equal(t, tc.expCode, revertErr.ErrorCode())
+			require.Equal(t, tc.expData, revertErr.ErrorData())
+		})
+	}
+}
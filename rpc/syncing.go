@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/tharsis/ethermint/rpc/ethereum/pubsub"
+	"github.com/tharsis/ethermint/rpc/ethereum/types"
+)
+
+// statusClient is the Tendermint client queried by the syncingPoller.
+type statusClient interface {
+	Status(context.Context) (*coretypes.ResultStatus, error)
+}
+
+// syncingPoller polls the Tendermint status for all the syncing subscriptions,
+// it runs while there is at least one subscriber.
+type syncingPoller struct {
+	client   statusClient
+	interval time.Duration
+	logger   log.Logger
+
+	mu   sync.Mutex
+	subs map[rpc.ID]*syncingSub
+	// last is the last polled sync status, nil until the first poll
+	last *types.SyncingResult
+	// stop stops the poll loop, nil when it's not running
+	stop chan struct{}
+}
+
+// syncingSub is a subscriber of the syncingPoller.
+type syncingSub struct {
+	notify func(*types.SyncingResult) error
+	// ready is closed once the subscriber can be notified
+	ready <-chan struct{}
+	// last is the last sync status notified to the subscriber, nil until the first notification succeeds
+	last *types.SyncingResult
+}
+
+func newSyncingPoller(client statusClient, interval time.Duration, logger log.Logger) *syncingPoller {
+	return &syncingPoller{
+		client:   client,
+		interval: interval,
+		logger:   logger,
+		subs:     make(map[rpc.ID]*syncingSub),
+	}
+}
+
+// Subscribe notifies the sync status to the subscriber once ready is closed and
+// whenever the node starts or stops catching up. The failed notifications are
+// retried on the next poll.
+func (p *syncingPoller) Subscribe(id rpc.ID, ready <-chan struct{}, notify func(*types.SyncingResult) error) pubsub.UnsubscribeFunc {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sub := &syncingSub{notify: notify, ready: ready}
+	p.subs[id] = sub
+
+	if p.stop == nil {
+		p.stop = make(chan struct{})
+		go p.run(p.stop)
+	}
+
+	// the last polled status is notified without waiting for the next poll
+	go func() {
+		<-ready
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if p.subs[id] == sub {
+			p.notify(id, sub)
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+
+			delete(p.subs, id)
+			if len(p.subs) == 0 && p.stop != nil {
+				close(p.stop)
+				p.stop = nil
+				p.last = nil
+			}
+		})
+	}
+}
+
+// run polls the status until stop is closed.
+func (p *syncingPoller) run(stop chan struct{}) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.poll(stop)
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll queries the status and notifies the subscribers whose last notified
+// status is outdated.
+func (p *syncingPoller) poll(stop chan struct{}) {
+	status, err := p.client.Status(context.Background())
+	if err != nil {
+		p.logger.Debug("failed to query the node status", "error", err.Error())
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// the poll loop was stopped during the query
+	if p.stop != stop {
+		return
+	}
+
+	p.last = &types.SyncingResult{
+		Syncing: status.SyncInfo.CatchingUp,
+		Status: types.SyncProgress{
+			StartingBlock: hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
+			CurrentBlock:  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+			HighestBlock:  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+		},
+	}
+	for id, sub := range p.subs {
+		p.notify(id, sub)
+	}
+}
+
+// notify sends the last polled status to the ready subscriber if it changed
+// since its last notification. The caller must hold the lock.
+func (p *syncingPoller) notify(id rpc.ID, sub *syncingSub) {
+	select {
+	case <-sub.ready:
+	default:
+		return
+	}
+
+	if p.last == nil || (sub.last != nil && sub.last.Syncing == p.last.Syncing) {
+		return
+	}
+	if err := sub.notify(p.last); err != nil {
+		p.logger.Debug("failed to notify sync status", "subscription-id", id, "error", err.Error())
+		return
+	}
+	sub.last = p.last
+}
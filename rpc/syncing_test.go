@@ -0,0 +1,134 @@
+package rpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/tharsis/ethermint/rpc/ethereum/types"
+)
+
+// testStatusClient returns the configured catching up state and counts the queries.
+type testStatusClient struct {
+	mu         sync.Mutex
+	catchingUp bool
+	queries    int
+}
+
+func (c *testStatusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.queries++
+	return &coretypes.ResultStatus{SyncInfo: coretypes.SyncInfo{CatchingUp: c.catchingUp, LatestBlockHeight: 10}}, nil
+}
+
+func (c *testStatusClient) numQueries() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.queries
+}
+
+// testSyncingSub records the notified sync states, its notifications fail while failing is set.
+type testSyncingSub struct {
+	mu       sync.Mutex
+	failing  bool
+	notified []bool
+}
+
+func (s *testSyncingSub) notify(result *types.SyncingResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.failing {
+		return errSlowConsumer
+	}
+	s.notified = append(s.notified, result.Syncing)
+	return nil
+}
+
+func (s *testSyncingSub) setFailing(failing bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failing = failing
+}
+
+func (s *testSyncingSub) notifications() []bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]bool{}, s.notified...)
+}
+
+func TestSyncingPoller(t *testing.T) {
+	client := &testStatusClient{catchingUp: true}
+	// the polls after the first one are triggered by the test
+	poller := newSyncingPoller(client, time.Hour, log.NewNopLogger())
+
+	ready1 := make(chan struct{})
+	close(ready1)
+	sub1 := &testSyncingSub{failing: true}
+	unsub1 := poller.Subscribe(rpc.NewID(), ready1, sub1.notify)
+	require.Eventually(t, func() bool { return client.numQueries() == 1 }, time.Second, 10*time.Millisecond)
+
+	poller.mu.Lock()
+	stop := poller.stop
+	poller.mu.Unlock()
+	require.NotNil(t, stop)
+
+	// the failed notification is retried on the next poll
+	require.Empty(t, sub1.notifications())
+	sub1.setFailing(false)
+	poller.poll(stop)
+	require.Equal(t, []bool{true}, sub1.notifications())
+
+	// the unchanged status isn't notified again
+	poller.poll(stop)
+	require.Equal(t, []bool{true}, sub1.notifications())
+
+	// a new subscriber isn't notified until it's ready
+	ready2 := make(chan struct{})
+	sub2 := &testSyncingSub{}
+	unsub2 := poller.Subscribe(rpc.NewID(), ready2, sub2.notify)
+	poller.poll(stop)
+	require.Empty(t, sub2.notifications())
+	require.Equal(t, 4, client.numQueries())
+
+	// then it's notified of the last polled status without a new query
+	close(ready2)
+	require.Eventually(t, func() bool { return len(sub2.notifications()) == 1 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, []bool{true}, sub2.notifications())
+	require.Equal(t, 4, client.numQueries())
+
+	// both subscribers are notified by a single query when the node stops catching up
+	client.mu.Lock()
+	client.catchingUp = false
+	client.mu.Unlock()
+	poller.poll(stop)
+	require.Equal(t, 5, client.numQueries())
+	require.Equal(t, []bool{true, false}, sub1.notifications())
+	require.Equal(t, []bool{true, false}, sub2.notifications())
+
+	// the poll loop stops with the last subscriber
+	unsub1()
+	poller.mu.Lock()
+	require.NotNil(t, poller.stop)
+	poller.mu.Unlock()
+
+	unsub2()
+	poller.mu.Lock()
+	require.Nil(t, poller.stop)
+	require.Nil(t, poller.last)
+	poller.mu.Unlock()
+
+	// the polls of the stopped loop are ignored
+	poller.poll(stop)
+	poller.mu.Lock()
+	require.Nil(t, poller.last)
+	poller.mu.Unlock()
+}
@@ -10,8 +10,10 @@ import (
 	"net"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
@@ -23,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/tendermint/tendermint/libs/log"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
 	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -72,6 +75,12 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+	// sendQueueSize is the max number of notifications buffered per connection
+	sendQueueSize int
+	// slowConsumerPolicy is applied to the connections whose send queue is full
+	slowConsumerPolicy string
+	// cosmosEventsConnCap is the max number of distinct cosmosEvents queries per connection
+	cosmosEventsConnCap int
 }
 
 func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg config.Config) WebsocketsServer {
@@ -83,8 +92,12 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:      newPubSubAPI(clientCtx, logger, tmWSClient, int(cfg.JSONRPC.WsCosmosEventsCap)),
 		logger:   logger,
+
+		sendQueueSize:       int(cfg.JSONRPC.WsSendQueueSize),
+		slowConsumerPolicy:  cfg.JSONRPC.WsSlowConsumerPolicy,
+		cosmosEventsConnCap: int(cfg.JSONRPC.WsCosmosEventsConnCap),
 	}
 }
 
@@ -123,10 +136,9 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
-	})
+	wsConn := newWSConn(conn, s.sendQueueSize, s.slowConsumerPolicy, s.cosmosEventsConnCap, s.logger)
+	go wsConn.writeLoop()
+	s.readLoop(wsConn)
 }
 
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
@@ -142,9 +154,41 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res)
 }
 
+// errSlowConsumer is returned when a notification is not queued because the send queue of the connection is full.
+var errSlowConsumer = errors.New("websocket send queue is full")
+
+// syncingPollInterval is the interval of the Tendermint status polls of the syncing subscriptions.
+const syncingPollInterval = time.Second
+
 type wsConn struct {
 	conn *websocket.Conn
 	mux  *sync.Mutex
+
+	// sendQueue buffers the subscription notifications and responses until they
+	// are written by writeLoop, so the subscriptions never block on a slow client.
+	sendQueue          chan interface{}
+	slowConsumerPolicy string
+	closed             chan struct{}
+	closeOnce          sync.Once
+	logger             log.Logger
+
+	// cosmosQueries counts the cosmosEvents subscriptions of the connection by
+	// query, it's only accessed by the read loop.
+	cosmosQueries       map[string]int
+	cosmosEventsConnCap int
+}
+
+func newWSConn(conn *websocket.Conn, sendQueueSize int, slowConsumerPolicy string, cosmosEventsConnCap int, logger log.Logger) *wsConn {
+	return &wsConn{
+		conn:                conn,
+		mux:                 new(sync.Mutex),
+		sendQueue:           make(chan interface{}, sendQueueSize),
+		slowConsumerPolicy:  slowConsumerPolicy,
+		closed:              make(chan struct{}),
+		logger:              logger,
+		cosmosQueries:       make(map[string]int),
+		cosmosEventsConnCap: cosmosEventsConnCap,
+	}
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -154,10 +198,63 @@ func (w *wsConn) WriteJSON(v interface{}) error {
 	return w.conn.WriteJSON(v)
 }
 
-func (w *wsConn) Close() error {
-	w.mux.Lock()
-	defer w.mux.Unlock()
+// Notify queues a subscription notification without blocking. When the send
+// queue is full, the notification is dropped and the connection is closed if
+// the slow consumer policy is to disconnect.
+func (w *wsConn) Notify(v interface{}) error {
+	select {
+	case <-w.closed:
+		return websocket.ErrCloseSent
+	default:
+	}
+
+	select {
+	case w.sendQueue <- v:
+		return nil
+	default:
+	}
 
+	telemetry.IncrCounter(1, "json_rpc", "ws", "dropped_notifications")
+	if w.slowConsumerPolicy == config.WsSlowConsumerDisconnect {
+		telemetry.IncrCounter(1, "json_rpc", "ws", "slow_consumer_disconnects")
+		w.logger.Debug("disconnecting slow websocket consumer", "remote", w.conn.RemoteAddr().String())
+		_ = w.Close()
+	}
+	return errSlowConsumer
+}
+
+// Send queues a subscription response after the notifications already queued,
+// it blocks until the response is queued or the connection is closed.
+func (w *wsConn) Send(v interface{}) error {
+	select {
+	case w.sendQueue <- v:
+		return nil
+	case <-w.closed:
+		return websocket.ErrCloseSent
+	}
+}
+
+// writeLoop writes the queued notifications to the connection until it's
+// closed.
+func (w *wsConn) writeLoop() {
+	for {
+		select {
+		case v := <-w.sendQueue:
+			if err := w.WriteJSON(v); err != nil {
+				w.logger.Debug("error writing notification, will drop peer", "error", err.Error())
+				_ = w.Close()
+				return
+			}
+		case <-w.closed:
+			return
+		}
+	}
+}
+
+// Close closes the connection, it doesn't wait for the pending write since the
+// websocket close can be called concurrently with the writes.
+func (w *wsConn) Close() error {
+	w.closeOnce.Do(func() { close(w.closed) })
 	return w.conn.Close()
 }
 
@@ -224,7 +321,8 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 			}
 
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			ready := make(chan struct{})
+			unsubFn, err := s.api.subscribe(wsConn, subID, params, ready)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
@@ -237,7 +335,10 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				Result:  subID,
 			}
 
-			if err := wsConn.WriteJSON(res); err != nil {
+			// the response is queued before the notifications of the subscription
+			err = wsConn.Send(res)
+			close(ready)
+			if err != nil {
 				break
 			}
 		case "eth_unsubscribe":
@@ -271,7 +372,8 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				Result:  ok,
 			}
 
-			if err := wsConn.WriteJSON(res); err != nil {
+			// the response follows the notifications queued before the unsubscription
+			if err := wsConn.Send(res); err != nil {
 				break
 			}
 		default:
@@ -319,19 +421,34 @@ type pubSubAPI struct {
 	events    *rpcfilters.EventSystem
 	logger    log.Logger
 	clientCtx client.Context
+
+	// cosmosEventsCap is the max number of distinct cosmosEvents queries of all the connections, each of
+	// them uses a subscription of the Tendermint websocket client.
+	cosmosEventsCap int
+	cosmosQueriesMu sync.Mutex
+	// cosmosQueries counts the cosmosEvents subscriptions of all the connections by query
+	cosmosQueries map[string]int
+
+	// syncing polls the Tendermint status for the syncing subscriptions
+	syncing *syncingPoller
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cosmosEventsCap int) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
-		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:          rpcfilters.NewEventSystem(logger, tmWSClient),
+		logger:          logger,
+		clientCtx:       clientCtx,
+		cosmosEventsCap: cosmosEventsCap,
+		cosmosQueries:   make(map[string]int),
+		syncing:         newSyncingPoller(clientCtx.Client, syncingPollInterval, logger),
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribe creates the subscription of the given params, its notifications are
+// sent once ready is closed, i.e. after the subscription response.
+func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return nil, errors.New("invalid parameters")
@@ -340,22 +457,35 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 	switch method {
 	case "newHeads":
 		// TODO: handle extra params
-		return api.subscribeNewHeads(wsConn, subID)
+		return api.subscribeNewHeads(wsConn, subID, ready)
 	case "logs":
 		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
+			return api.subscribeLogs(wsConn, subID, params[1], ready)
 		}
-		return api.subscribeLogs(wsConn, subID, nil)
+		return api.subscribeLogs(wsConn, subID, nil, ready)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		fullTx, err := parseFullTxParam(params)
+		if err != nil {
+			return nil, err
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx, ready)
 	case "syncing":
-		return api.subscribeSyncing(wsConn, subID)
+		return api.subscribeSyncing(wsConn, subID, ready)
+	case "cosmosEvents":
+		if len(params) < 2 {
+			return nil, errors.New("missing query parameter")
+		}
+		query, ok := params[1].(string)
+		if !ok {
+			return nil, errors.Errorf("invalid query parameter, expected a string, got %T", params[1])
+		}
+		return api.subscribeCosmosEvents(wsConn, subID, query, ready)
 	default:
 		return nil, errors.Errorf("unsupported method %s", method)
 	}
 }
 
-func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribeNewHeads()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter")
@@ -365,6 +495,8 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 	baseFee := big.NewInt(params.InitialBaseFee)
 
 	go func() {
+		<-ready
+
 		headersCh := sub.Event()
 		errCh := sub.Err()
 		for {
@@ -392,15 +524,8 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 					},
 				}
 
-				err = wsConn.WriteJSON(res)
-				if err != nil {
-					api.logger.Error("error writing header, will drop peer", "error", err.Error())
-
-					try(func() {
-						if err != websocket.ErrCloseSent {
-							_ = wsConn.Close()
-						}
-					}, api.logger, "closing websocket peer sub")
+				if err := wsConn.Notify(res); err != nil {
+					api.logger.Debug("failed to notify header", "subscription-id", subID, "error", err.Error())
 				}
 			case err, ok := <-errCh:
 				if !ok {
@@ -411,27 +536,13 @@ func (api *pubSubAPI) subscribeNewHeads(wsConn *wsConn, subID rpc.ID) (pubsub.Un
 		}
 	}()
 
-	return unsubFn, nil
-}
-
-func try(fn func(), l log.Logger, desc string) {
-	defer func() {
-		if x := recover(); x != nil {
-			if err, ok := x.(error); ok {
-				// debug.PrintStack()
-				l.Debug("panic during "+desc, "error", err.Error())
-				return
-			}
-
-			l.Debug(fmt.Sprintf("panic during %s: %+v", desc, x))
-			return
-		}
-	}()
-
-	fn()
+	return func() {
+		unsubFn()
+		sub.Unsubscribe(api.events)
+	}, nil
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
 	crit := filters.FilterCriteria{}
 
 	if extra != nil {
@@ -538,6 +649,8 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	}
 
 	go func() {
+		<-ready
+
 		ch := sub.Event()
 		errCh := sub.Err()
 		for {
@@ -574,13 +687,8 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 						},
 					}
 
-					err = wsConn.WriteJSON(res)
-					if err != nil {
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close()
-							}
-						}, api.logger, "closing websocket peer sub")
+					if err := wsConn.Notify(res); err != nil {
+						api.logger.Debug("failed to notify log", "subscription-id", subID, "error", err.Error())
 					}
 				}
 			case err, ok := <-errCh:
@@ -592,21 +700,44 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		}
 	}()
 
-	return unsubFn, nil
+	return func() {
+		unsubFn()
+		sub.Unsubscribe(api.events)
+	}, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+// parseFullTxParam returns the optional full tx parameter of the newPendingTransactions subscriptions.
+func parseFullTxParam(params []interface{}) (bool, error) {
+	if len(params) < 2 {
+		return false, nil
+	}
+	fullTx, ok := params[1].(bool)
+	if !ok {
+		return false, errors.Errorf("invalid full tx parameter, expected a boolean, got %T", params[1])
+	}
+	return fullTx, nil
+}
+
+// subscribePendingTransactions notifies the hashes of the eth txs entering the
+// mempool, or the full txs if fullTx is true.
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
 	}
 
 	go func() {
+		<-ready
+
 		txsCh := sub.Event()
 		errCh := sub.Err()
 		for {
 			select {
-			case ev := <-txsCh:
+			case ev, ok := <-txsCh:
+				if !ok {
+					return
+				}
+
 				data, ok := ev.Data.(tmtypes.EventDataTx)
 				if !ok {
 					api.logger.Debug("event data type mismatch", "type", fmt.Sprintf("%T", ev.Data))
@@ -620,25 +751,24 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
+					result, err := rpcfilters.PendingTxResult(ethTx, fullTx)
+					if err != nil {
+						api.logger.Debug("failed to format pending tx", "hash", ethTx.Hash, "error", err.Error())
+						continue
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
-					err = wsConn.WriteJSON(res)
-					if err != nil {
-						api.logger.Debug("error writing header, will drop peer", "error", err.Error())
-
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close()
-							}
-						}, api.logger, "closing websocket peer sub")
+					if err := wsConn.Notify(res); err != nil {
+						api.logger.Debug("failed to notify pending tx", "subscription-id", subID, "error", err.Error())
 					}
 				}
 			case err, ok := <-errCh:
@@ -650,9 +780,117 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 		}
 	}()
 
-	return unsubFn, nil
+	return func() {
+		unsubFn()
+		sub.Unsubscribe(api.events)
+	}, nil
+}
+
+// subscribeCosmosEvents notifies the ABCI events of the txs and blocks matching the
+// Tendermint query, e.g. "tm.event='Tx' AND transfer.recipient='ethm1...'". The
+// distinct queries are capped per connection and in total since each of them
+// uses a subscription of the Tendermint websocket client, except the queries of
+// the eth subscriptions which share their subscriptions.
+func (api *pubSubAPI) subscribeCosmosEvents(wsConn *wsConn, subID rpc.ID, query string, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
+	q, err := tmquery.New(query)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid query %s", query)
+	}
+	query = q.String()
+	capped := !rpcfilters.IsEthEventsQuery(query)
+
+	if capped && wsConn.cosmosQueries[query] == 0 && len(wsConn.cosmosQueries) >= wsConn.cosmosEventsConnCap {
+		return nil, errors.Errorf("max limit of %d cosmos events queries per connection reached", wsConn.cosmosEventsConnCap)
+	}
+
+	api.cosmosQueriesMu.Lock()
+	defer api.cosmosQueriesMu.Unlock()
+
+	if capped && api.cosmosQueries[query] == 0 && len(api.cosmosQueries) >= api.cosmosEventsCap {
+		return nil, errors.Errorf("max limit of %d cosmos events queries reached", api.cosmosEventsCap)
+	}
+
+	sub, unsubFn, err := api.events.SubscribeCosmosEvents(query)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating cosmos events filter")
+	}
+
+	if capped {
+		api.cosmosQueries[query]++
+		wsConn.cosmosQueries[query]++
+	}
+
+	go func() {
+		<-ready
+
+		eventsCh := sub.Event()
+		errCh := sub.Err()
+		for {
+			select {
+			case ev, ok := <-eventsCh:
+				if !ok {
+					return
+				}
+
+				// write to ws conn
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       types.NewCosmosEventsResult(ev),
+					},
+				}
+
+				if err := wsConn.Notify(res); err != nil {
+					api.logger.Debug("failed to notify cosmos events", "subscription-id", subID, "error", err.Error())
+				}
+			case err, ok := <-errCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping CosmosEvents WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			}
+		}
+	}()
+
+	return func() {
+		unsubFn()
+		sub.Unsubscribe(api.events)
+		if !capped {
+			return
+		}
+
+		if wsConn.cosmosQueries[query]--; wsConn.cosmosQueries[query] == 0 {
+			delete(wsConn.cosmosQueries, query)
+		}
+
+		api.cosmosQueriesMu.Lock()
+		defer api.cosmosQueriesMu.Unlock()
+		if api.cosmosQueries[query]--; api.cosmosQueries[query] == 0 {
+			delete(api.cosmosQueries, query)
+		}
+	}, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+// subscribeSyncing notifies the sync state of the node after the subscription
+// response and whenever the node starts or stops catching up. The Tendermint
+// status is polled once for all the subscriptions.
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID, ready <-chan struct{}) (pubsub.UnsubscribeFunc, error) {
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription requires a tendermint client")
+	}
+
+	return api.syncing.Subscribe(subID, ready, func(result *types.SyncingResult) error {
+		// write to ws conn
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		}
+		return wsConn.Notify(res)
+	}), nil
 }
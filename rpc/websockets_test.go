@@ -0,0 +1,183 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmrpcclient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/tharsis/ethermint/server/config"
+)
+
+func TestWSConnNotify(t *testing.T) {
+	testCases := []struct {
+		name          string
+		policy        string
+		expDisconnect bool
+	}{
+		{"drop the notifications", config.WsSlowConsumerDrop, false},
+		{"disconnect the slow consumer", config.WsSlowConsumerDisconnect, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			connCh := make(chan *websocket.Conn, 1)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+				require.NoError(t, err)
+				connCh <- conn
+			}))
+			defer srv.Close()
+
+			client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+			require.NoError(t, err)
+			defer client.Close()
+
+			// the write loop is not started, so the send queue is never drained
+			wsConn := newWSConn(<-connCh, 2, tc.policy, 1, log.NewNopLogger())
+			require.NoError(t, wsConn.Notify(1))
+			require.NoError(t, wsConn.Notify(2))
+			require.ErrorIs(t, wsConn.Notify(3), errSlowConsumer)
+
+			if tc.expDisconnect {
+				require.ErrorIs(t, wsConn.Notify(4), websocket.ErrCloseSent)
+				return
+			}
+			require.ErrorIs(t, wsConn.Notify(4), errSlowConsumer)
+
+			// the queued notifications are written once the write loop runs
+			go wsConn.writeLoop()
+			var v int
+			require.NoError(t, client.ReadJSON(&v))
+			require.Equal(t, 1, v)
+			require.NoError(t, client.ReadJSON(&v))
+			require.Equal(t, 2, v)
+			require.NoError(t, wsConn.Close())
+		})
+	}
+}
+
+func TestParseFullTxParam(t *testing.T) {
+	testCases := []struct {
+		name      string
+		params    []interface{}
+		expFullTx bool
+		expErr    bool
+	}{
+		{"no full tx param", []interface{}{"newPendingTransactions"}, false, false},
+		{"full txs", []interface{}{"newPendingTransactions", true}, true, false},
+		{"tx hashes", []interface{}{"newPendingTransactions", false}, false, false},
+		{"non bool full tx param", []interface{}{"newPendingTransactions", "true"}, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fullTx, err := parseFullTxParam(tc.params)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expFullTx, fullTx)
+		})
+	}
+
+	// the invalid param is rejected before subscribing
+	_, err := (&pubSubAPI{}).subscribe(nil, rpc.NewID(), []interface{}{"newPendingTransactions", 1}, nil)
+	require.Error(t, err)
+}
+
+func TestSubscribeCosmosEventsCaps(t *testing.T) {
+	query := "tm.event='Tx' AND transfer.recipient='recipient'"
+	otherQuery := "tm.event='NewBlock'"
+
+	testCases := []struct {
+		name          string
+		query         string
+		connQueries   map[string]int
+		globalQueries map[string]int
+	}{
+		{
+			"invalid query",
+			"tm.event=",
+			map[string]int{},
+			map[string]int{},
+		},
+		{
+			"connection cap reached",
+			query,
+			map[string]int{otherQuery: 1},
+			map[string]int{},
+		},
+		{
+			"global cap reached",
+			query,
+			map[string]int{},
+			map[string]int{otherQuery: 1, "tm.event = 'Tx' AND transfer.sender = 'sender'": 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api := &pubSubAPI{cosmosEventsCap: 2, cosmosQueries: tc.globalQueries}
+			wsConn := &wsConn{cosmosQueries: tc.connQueries, cosmosEventsConnCap: 1}
+
+			// the queries over the caps are rejected before subscribing to the event system
+			_, err := api.subscribeCosmosEvents(wsConn, rpc.NewID(), tc.query, nil)
+			require.Error(t, err)
+			require.Len(t, api.cosmosQueries, len(tc.globalQueries))
+			require.Len(t, wsConn.cosmosQueries, len(tc.connQueries))
+		})
+	}
+}
+
+func TestSubscribeSyncingResponseOrder(t *testing.T) {
+	logger := log.NewNopLogger()
+	api := &pubSubAPI{
+		logger: logger,
+		// the syncing subscriptions only check that the Tendermint client is set
+		clientCtx: client.Context{}.WithClient(struct{ tmrpcclient.Client }{}),
+		syncing:   newSyncingPoller(&testStatusClient{catchingUp: true}, time.Hour, logger),
+	}
+	srv := httptest.NewServer(&websocketsServer{
+		api:                api,
+		logger:             logger,
+		sendQueueSize:      10,
+		slowConsumerPolicy: config.WsSlowConsumerDrop,
+	})
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the status is already polled for the second subscription
+	for id := 1; id <= 2; id++ {
+		req := map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": "eth_subscribe", "params": []interface{}{"syncing"}}
+		require.NoError(t, conn.WriteJSON(req))
+
+		var res SubscriptionResponseJSON
+		require.NoError(t, conn.ReadJSON(&res))
+		require.Equal(t, float64(id), res.ID)
+		subID, ok := res.Result.(string)
+		require.True(t, ok)
+
+		var notification struct {
+			Method string `json:"method"`
+			Params struct {
+				Subscription string `json:"subscription"`
+			} `json:"params"`
+		}
+		require.NoError(t, conn.ReadJSON(&notification))
+		require.Equal(t, "eth_subscription", notification.Method)
+		require.Equal(t, subID, notification.Params.Subscription)
+	}
+}
@@ -47,10 +47,37 @@ const (
 	DefaultHTTPTimeout = 30 * time.Second
 
 	DefaultHTTPIdleTimeout = 120 * time.Second
+
+	DefaultTxQueueSenderCap int32 = 64
+
+	DefaultTxQueueCap int32 = 1024
+
+	DefaultPriceBump uint64 = 10
+
+	DefaultWsSendQueueSize int32 = 256
+
+	// WsSlowConsumerDrop drops the notifications of the websocket clients whose send queue is full
+	WsSlowConsumerDrop = "drop"
+	// WsSlowConsumerDisconnect disconnects the websocket clients whose send queue is full
+	WsSlowConsumerDisconnect = "disconnect"
+
+	DefaultWsSlowConsumerPolicy = WsSlowConsumerDrop
+
+	// DefaultWsCosmosEventsCap keeps the queries of the Tendermint websocket client within the default
+	// max_subscriptions_per_client of 5, the eth subscriptions use 3 of them.
+	DefaultWsCosmosEventsCap int32 = 2
+
+	DefaultWsCosmosEventsConnCap int32 = 1
+
+	DefaultTraceBlockChunkSize int32 = 50
+
+	DefaultTraceBlockReplayCap int32 = 10000
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 
+var wsSlowConsumerPolicies = []string{WsSlowConsumerDrop, WsSlowConsumerDisconnect}
+
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
 type Config struct {
@@ -98,6 +125,30 @@ type JSONRPCConfig struct {
 	HTTPTimeout time.Duration `mapstructure:"http-timeout"`
 	// HTTPIdleTimeout is the idle timeout of http json-rpc server.
 	HTTPIdleTimeout time.Duration `mapstructure:"http-idle-timeout"`
+	// EnableIndexer defines if enable the custom indexer service.
+	EnableIndexer bool `mapstructure:"enable-indexer"`
+	// TxQueueSenderCap defines the max number of future nonce eth txs queued for a sender.
+	TxQueueSenderCap int32 `mapstructure:"txqueue-sender-cap"`
+	// TxQueueCap defines the max number of future nonce eth txs queued in total.
+	TxQueueCap int32 `mapstructure:"txqueue-cap"`
+	// PriceBump defines the min effective tip increase in percent for a tx to replace a tx with the same nonce.
+	PriceBump uint64 `mapstructure:"price-bump"`
+	// PersistFilters defines if the polling filters are persisted across the node restarts.
+	PersistFilters bool `mapstructure:"persist-filters"`
+	// WsSendQueueSize defines the max number of subscription notifications buffered per websocket connection.
+	WsSendQueueSize int32 `mapstructure:"ws-send-queue-size"`
+	// WsSlowConsumerPolicy defines what happens to a websocket client whose send queue is full, drop or disconnect.
+	WsSlowConsumerPolicy string `mapstructure:"ws-slow-consumer-policy"`
+	// WsCosmosEventsCap defines the max number of distinct queries of the cosmosEvents subscriptions in total.
+	WsCosmosEventsCap int32 `mapstructure:"ws-cosmos-events-cap"`
+	// WsCosmosEventsConnCap defines the max number of distinct queries of the cosmosEvents subscriptions per
+	// websocket connection.
+	WsCosmosEventsConnCap int32 `mapstructure:"ws-cosmos-events-conn-cap"`
+	// TraceBlockChunkSize defines the max number of eth txs traced by a single trace block query.
+	TraceBlockChunkSize int32 `mapstructure:"trace-block-chunk-size"`
+	// TraceBlockReplayCap defines the max number of eth txs replayed in total by the chunks of a block trace, the
+	// blocks exceeding it are traced in a single query.
+	TraceBlockReplayCap int32 `mapstructure:"trace-block-replay-cap"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -183,19 +234,30 @@ func GetAPINamespaces() []string {
 // DefaultJSONRPCConfig returns an EVM config with the JSON-RPC API enabled by default
 func DefaultJSONRPCConfig() *JSONRPCConfig {
 	return &JSONRPCConfig{
-		Enable:          true,
-		API:             GetDefaultAPINamespaces(),
-		Address:         DefaultJSONRPCAddress,
-		WsAddress:       DefaultJSONRPCWsAddress,
-		GasCap:          DefaultGasCap,
-		EVMTimeout:      DefaultEVMTimeout,
-		TxFeeCap:        DefaultTxFeeCap,
-		FilterCap:       DefaultFilterCap,
-		FeeHistoryCap:   DefaultFeeHistoryCap,
-		BlockRangeCap:   DefaultBlockRangeCap,
-		LogsCap:         DefaultLogsCap,
-		HTTPTimeout:     DefaultHTTPTimeout,
-		HTTPIdleTimeout: DefaultHTTPIdleTimeout,
+		Enable:                true,
+		API:                   GetDefaultAPINamespaces(),
+		Address:               DefaultJSONRPCAddress,
+		WsAddress:             DefaultJSONRPCWsAddress,
+		GasCap:                DefaultGasCap,
+		EVMTimeout:            DefaultEVMTimeout,
+		TxFeeCap:              DefaultTxFeeCap,
+		FilterCap:             DefaultFilterCap,
+		FeeHistoryCap:         DefaultFeeHistoryCap,
+		BlockRangeCap:         DefaultBlockRangeCap,
+		LogsCap:               DefaultLogsCap,
+		HTTPTimeout:           DefaultHTTPTimeout,
+		HTTPIdleTimeout:       DefaultHTTPIdleTimeout,
+		EnableIndexer:         false,
+		TxQueueSenderCap:      DefaultTxQueueSenderCap,
+		TxQueueCap:            DefaultTxQueueCap,
+		PriceBump:             DefaultPriceBump,
+		PersistFilters:        false,
+		WsSendQueueSize:       DefaultWsSendQueueSize,
+		WsSlowConsumerPolicy:  DefaultWsSlowConsumerPolicy,
+		WsCosmosEventsCap:     DefaultWsCosmosEventsCap,
+		WsCosmosEventsConnCap: DefaultWsCosmosEventsConnCap,
+		TraceBlockChunkSize:   DefaultTraceBlockChunkSize,
+		TraceBlockReplayCap:   DefaultTraceBlockReplayCap,
 	}
 }
 
@@ -237,6 +299,38 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.TxQueueSenderCap < 0 {
+		return errors.New("JSON-RPC tx queue sender cap cannot be negative")
+	}
+
+	if c.TxQueueCap < 0 {
+		return errors.New("JSON-RPC tx queue cap cannot be negative")
+	}
+
+	if c.WsSendQueueSize <= 0 {
+		return errors.New("JSON-RPC websocket send queue size must be positive")
+	}
+
+	if !strings.StringInSlice(c.WsSlowConsumerPolicy, wsSlowConsumerPolicies) {
+		return fmt.Errorf("invalid websocket slow consumer policy %s, available policies: %v", c.WsSlowConsumerPolicy, wsSlowConsumerPolicies)
+	}
+
+	if c.WsCosmosEventsCap < 0 {
+		return errors.New("JSON-RPC websocket cosmos events cap cannot be negative")
+	}
+
+	if c.WsCosmosEventsConnCap < 0 {
+		return errors.New("JSON-RPC websocket cosmos events connection cap cannot be negative")
+	}
+
+	if c.TraceBlockChunkSize <= 0 {
+		return errors.New("JSON-RPC trace block chunk size must be positive")
+	}
+
+	if c.TraceBlockReplayCap < 0 {
+		return errors.New("JSON-RPC trace block replay cap cannot be negative")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -289,19 +383,30 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			MaxTxGasWanted: v.GetUint64("evm.max-tx-gas-wanted"),
 		},
 		JSONRPC: JSONRPCConfig{
-			Enable:          v.GetBool("json-rpc.enable"),
-			API:             v.GetStringSlice("json-rpc.api"),
-			Address:         v.GetString("json-rpc.address"),
-			WsAddress:       v.GetString("json-rpc.ws-address"),
-			GasCap:          v.GetUint64("json-rpc.gas-cap"),
-			FilterCap:       v.GetInt32("json-rpc.filter-cap"),
-			FeeHistoryCap:   v.GetInt32("json-rpc.feehistory-cap"),
-			TxFeeCap:        v.GetFloat64("json-rpc.txfee-cap"),
-			EVMTimeout:      v.GetDuration("json-rpc.evm-timeout"),
-			LogsCap:         v.GetInt32("json-rpc.logs-cap"),
-			BlockRangeCap:   v.GetInt32("json-rpc.block-range-cap"),
-			HTTPTimeout:     v.GetDuration("json-rpc.http-timeout"),
-			HTTPIdleTimeout: v.GetDuration("json-rpc.http-idle-timeout"),
+			Enable:                v.GetBool("json-rpc.enable"),
+			API:                   v.GetStringSlice("json-rpc.api"),
+			Address:               v.GetString("json-rpc.address"),
+			WsAddress:             v.GetString("json-rpc.ws-address"),
+			GasCap:                v.GetUint64("json-rpc.gas-cap"),
+			FilterCap:             v.GetInt32("json-rpc.filter-cap"),
+			FeeHistoryCap:         v.GetInt32("json-rpc.feehistory-cap"),
+			TxFeeCap:              v.GetFloat64("json-rpc.txfee-cap"),
+			EVMTimeout:            v.GetDuration("json-rpc.evm-timeout"),
+			LogsCap:               v.GetInt32("json-rpc.logs-cap"),
+			BlockRangeCap:         v.GetInt32("json-rpc.block-range-cap"),
+			HTTPTimeout:           v.GetDuration("json-rpc.http-timeout"),
+			HTTPIdleTimeout:       v.GetDuration("json-rpc.http-idle-timeout"),
+			EnableIndexer:         v.GetBool("json-rpc.enable-indexer"),
+			TxQueueSenderCap:      v.GetInt32("json-rpc.txqueue-sender-cap"),
+			TxQueueCap:            v.GetInt32("json-rpc.txqueue-cap"),
+			PriceBump:             v.GetUint64("json-rpc.price-bump"),
+			PersistFilters:        v.GetBool("json-rpc.persist-filters"),
+			WsSendQueueSize:       v.GetInt32("json-rpc.ws-send-queue-size"),
+			WsSlowConsumerPolicy:  v.GetString("json-rpc.ws-slow-consumer-policy"),
+			WsCosmosEventsCap:     v.GetInt32("json-rpc.ws-cosmos-events-cap"),
+			WsCosmosEventsConnCap: v.GetInt32("json-rpc.ws-cosmos-events-conn-cap"),
+			TraceBlockChunkSize:   v.GetInt32("json-rpc.trace-block-chunk-size"),
+			TraceBlockReplayCap:   v.GetInt32("json-rpc.trace-block-replay-cap"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
@@ -54,6 +54,8 @@ feehistory-cap = {{ .JSONRPC.FeeHistoryCap }}
 logs-cap = {{ .JSONRPC.LogsCap }}
 
 # BlockRangeCap defines the max block range allowed for 'eth_getLogs' query.
+# The ranges covered by the indexer log index are served without walking the blocks, with 'enable-indexer'
+# the cap can be raised to query large ranges.
 block-range-cap = {{ .JSONRPC.BlockRangeCap }}
 
 # HTTPTimeout is the read/write timeout of http json-rpc server.
@@ -62,6 +64,53 @@ http-timeout = "{{ .JSONRPC.HTTPTimeout }}"
 # HTTPIdleTimeout is the idle timeout of http json-rpc server.
 http-idle-timeout = "{{ .JSONRPC.HTTPIdleTimeout }}"
 
+# EnableIndexer enables the custom eth tx indexer, the receipts and tx lookups use it
+# instead of the tendermint tx_search. Historical txs are indexed with the 'index-eth-tx' command.
+enable-indexer = {{ .JSONRPC.EnableIndexer }}
+
+# TxQueueSenderCap is the max number of eth txs with a future nonce queued for a sender until the nonce gap
+# is filled, 0 disables the queue.
+txqueue-sender-cap = {{ .JSONRPC.TxQueueSenderCap }}
+
+# TxQueueCap is the max number of eth txs with a future nonce queued in total, 0 disables the queue.
+txqueue-cap = {{ .JSONRPC.TxQueueCap }}
+
+# PriceBump is the min effective tip increase in percent for an eth tx to replace a pending or queued tx
+# with the same sender and nonce. The replacements require the tx queue, only the pending txs broadcasted
+# by this node can be replaced and they are only evicted from the local mempool, the peers may still
+# include them in a block. Default: 10.
+price-bump = {{ .JSONRPC.PriceBump }}
+
+# PersistFilters persists the filters created with 'eth_newFilter', 'eth_newBlockFilter' and
+# 'eth_newPendingTransactionFilter' so they survive the node restarts, the block and log filter changes are
+# then collected from the chain by height when polled and resume without gap.
+persist-filters = {{ .JSONRPC.PersistFilters }}
+
+# WsSendQueueSize is the max number of subscription notifications buffered for a websocket connection.
+ws-send-queue-size = {{ .JSONRPC.WsSendQueueSize }}
+
+# WsSlowConsumerPolicy is applied to the websocket clients that don't read their notifications fast enough to
+# keep their send queue from filling up: "drop" drops the new notifications, "disconnect" closes the connection.
+ws-slow-consumer-policy = "{{ .JSONRPC.WsSlowConsumerPolicy }}"
+
+# WsCosmosEventsCap is the max number of distinct queries of the 'cosmosEvents' subscriptions of all the
+# websocket connections (0=disabled). Each query uses one of the Tendermint websocket client subscriptions,
+# limited by 'max_subscriptions_per_client' in the Tendermint config, and the eth subscriptions use 3 of them.
+ws-cosmos-events-cap = {{ .JSONRPC.WsCosmosEventsCap }}
+
+# WsCosmosEventsConnCap is the max number of distinct queries of the 'cosmosEvents' subscriptions of a
+# websocket connection.
+ws-cosmos-events-conn-cap = {{ .JSONRPC.WsCosmosEventsConnCap }}
+
+# TraceBlockChunkSize is the max number of eth txs traced by a single query of 'debug_traceBlockByNumber' and
+# 'debug_traceBlockByHash', the larger blocks are traced in chunks replaying the txs of the previous chunks.
+trace-block-chunk-size = {{ .JSONRPC.TraceBlockChunkSize }}
+
+# TraceBlockReplayCap is the max number of eth txs replayed in total by the chunks of a block trace. The chunk
+# i replays the i * trace-block-chunk-size txs before it, so the replays grow with the square of the block size
+# and the blocks exceeding the cap are traced in a single query.
+trace-block-replay-cap = {{ .JSONRPC.TraceBlockReplayCap }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
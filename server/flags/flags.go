@@ -32,18 +32,29 @@ const (
 
 // JSON-RPC flags
 const (
-	JSONRPCEnable          = "json-rpc.enable"
-	JSONRPCAPI             = "json-rpc.api"
-	JSONRPCAddress         = "json-rpc.address"
-	JSONWsAddress          = "json-rpc.ws-address"
-	JSONRPCGasCap          = "json-rpc.gas-cap"
-	JSONRPCEVMTimeout      = "json-rpc.evm-timeout"
-	JSONRPCTxFeeCap        = "json-rpc.txfee-cap"
-	JSONRPCFilterCap       = "json-rpc.filter-cap"
-	JSONRPCLogsCap         = "json-rpc.logs-cap"
-	JSONRPCBlockRangeCap   = "json-rpc.block-range-cap"
-	JSONRPCHTTPTimeout     = "json-rpc.http-timeout"
-	JSONRPCHTTPIdleTimeout = "json-rpc.http-idle-timeout"
+	JSONRPCEnable           = "json-rpc.enable"
+	JSONRPCAPI              = "json-rpc.api"
+	JSONRPCAddress          = "json-rpc.address"
+	JSONWsAddress           = "json-rpc.ws-address"
+	JSONRPCGasCap           = "json-rpc.gas-cap"
+	JSONRPCEVMTimeout       = "json-rpc.evm-timeout"
+	JSONRPCTxFeeCap         = "json-rpc.txfee-cap"
+	JSONRPCFilterCap        = "json-rpc.filter-cap"
+	JSONRPCLogsCap          = "json-rpc.logs-cap"
+	JSONRPCBlockRangeCap    = "json-rpc.block-range-cap"
+	JSONRPCHTTPTimeout      = "json-rpc.http-timeout"
+	JSONRPCHTTPIdleTimeout  = "json-rpc.http-idle-timeout"
+	JSONRPCEnableIndexer    = "json-rpc.enable-indexer"
+	JSONRPCTxQueueSenderCap = "json-rpc.txqueue-sender-cap"
+	JSONRPCTxQueueCap       = "json-rpc.txqueue-cap"
+	JSONRPCPriceBump        = "json-rpc.price-bump"
+	JSONRPCPersistFilters   = "json-rpc.persist-filters"
+	JSONRPCWsSendQueueSize  = "json-rpc.ws-send-queue-size"
+	JSONRPCWsSlowConsumer   = "json-rpc.ws-slow-consumer-policy"
+	JSONRPCWsCosmosEvents   = "json-rpc.ws-cosmos-events-cap"
+	JSONRPCWsCosmosConnCap  = "json-rpc.ws-cosmos-events-conn-cap"
+	JSONRPCTraceChunkSize   = "json-rpc.trace-block-chunk-size"
+	JSONRPCTraceReplayCap   = "json-rpc.trace-block-replay-cap"
 )
 
 // EVM flags
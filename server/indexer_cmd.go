@@ -0,0 +1,116 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	tmnode "github.com/tendermint/tendermint/node"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+
+	"github.com/tharsis/ethermint/indexer"
+)
+
+// NewIndexTxCmd returns the command to index the historical eth txs.
+func NewIndexTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "index-eth-tx [backward|forward]",
+		Short: "Index historical eth txs",
+		Long: `Index historical eth txs, it only supports two traverse directions to avoid creating gaps in the indexer db:
+- backward: index the blocks from the first indexed block down to the earliest block in the local block store.
+- forward: index the blocks from the last indexed block up to the latest block in the local block store.
+
+The node must be stopped while running this command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			direction := args[0]
+			if direction != "backward" && direction != "forward" {
+				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			}
+
+			cfg := serverCtx.Config
+			logger := serverCtx.Logger
+
+			idxDB, err := OpenIndexerDB(cfg.RootDir)
+			if err != nil {
+				logger.Error("failed to open evm indexer DB", "error", err.Error())
+				return err
+			}
+			defer idxDB.Close()
+
+			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
+
+			// open the local tendermint dbs, because the local rpc won't be available.
+			blockStoreDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+			blockStore := tmstore.NewBlockStore(blockStoreDB)
+
+			stateDB, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "state", Config: cfg})
+			if err != nil {
+				return err
+			}
+			defer stateDB.Close()
+			stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: false})
+
+			indexBlock := func(height int64) error {
+				block := blockStore.LoadBlock(height)
+				if block == nil {
+					return fmt.Errorf("block not found %d", height)
+				}
+				resBlock, err := stateStore.LoadABCIResponses(height)
+				if err != nil {
+					return err
+				}
+				if err := idxer.IndexBlock(block, resBlock.DeliverTxs); err != nil {
+					return err
+				}
+				cmd.Println(height)
+				return nil
+			}
+
+			switch direction {
+			case "backward":
+				first, err := idxer.FirstIndexedBlock()
+				if err != nil {
+					return err
+				}
+				if first == -1 {
+					return fmt.Errorf("indexer db is empty")
+				}
+				for i := first - 1; i >= blockStore.Base() && i > 0; i-- {
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
+			case "forward":
+				last, err := idxer.LastIndexedBlock()
+				if err != nil {
+					return err
+				}
+				if last == -1 {
+					// start from the earliest block if the db is empty
+					last = blockStore.Base() - 1
+				}
+				for i := last + 1; i <= blockStore.Height(); i++ {
+					if err := indexBlock(i); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/service"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	ethermint "github.com/tharsis/ethermint/types"
+)
+
+const (
+	// ServiceName is the name of the EVM indexer service
+	ServiceName = "EVMIndexerService"
+	// NewBlockWaitTimeout is the max time to wait for a new block notification
+	// before polling the latest height again.
+	NewBlockWaitTimeout = 60 * time.Second
+	// blockHeadersCapacity is the capacity of the new block headers subscription
+	blockHeadersCapacity = 100
+)
+
+// EVMIndexerService indexes the eth txs for the json-rpc service.
+type EVMIndexerService struct {
+	service.BaseService
+
+	txIdxr ethermint.EVMTxIndexer
+	client rpcclient.Client
+	cancel context.CancelFunc
+}
+
+// NewEVMIndexerService returns a new service instance.
+func NewEVMIndexerService(txIdxr ethermint.EVMTxIndexer, client rpcclient.Client) *EVMIndexerService {
+	is := &EVMIndexerService{txIdxr: txIdxr, client: client}
+	is.BaseService = *service.NewBaseService(nil, ServiceName, is)
+	return is
+}
+
+// OnStart implements service.Service by subscribing to the new block headers
+// and indexing the blocks in the background as they are committed. Starting
+// from an empty indexer db, only the blocks after the current one are indexed,
+// the historical ones are indexed with the `index-eth-tx` command.
+func (eis *EVMIndexerService) OnStart() error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	status, err := eis.client.Status(ctx)
+	if err != nil {
+		cancel()
+		return err
+	}
+	latestBlock := status.SyncInfo.LatestBlockHeight
+
+	blockHeadersChan, err := eis.client.Subscribe(ctx, ServiceName, tmtypes.EventQueryNewBlockHeader.String(), blockHeadersCapacity)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	lastBlock, err := eis.txIdxr.LastIndexedBlock()
+	if err != nil {
+		cancel()
+		return err
+	}
+	if lastBlock == -1 {
+		lastBlock = latestBlock
+	}
+
+	eis.cancel = cancel
+	go eis.indexLoop(ctx, blockHeadersChan, lastBlock, latestBlock)
+	return nil
+}
+
+// OnStop implements service.Service by stopping the indexing loop.
+func (eis *EVMIndexerService) OnStop() {
+	if eis.cancel != nil {
+		eis.cancel()
+	}
+
+	if err := eis.client.UnsubscribeAll(context.Background(), ServiceName); err != nil {
+		eis.Logger.Error("failed to unsubscribe from the new block headers", "error", err.Error())
+	}
+}
+
+// indexLoop indexes the blocks after lastBlock up to the latest one, then waits
+// for the next block header.
+func (eis *EVMIndexerService) indexLoop(ctx context.Context, blockHeadersChan <-chan coretypes.ResultEvent, lastBlock, latestBlock int64) {
+	for {
+		for lastBlock < latestBlock {
+			height := lastBlock + 1
+			if err := eis.indexBlock(ctx, height); err != nil {
+				eis.Logger.Error("failed to index block", "height", height, "error", err.Error())
+				break
+			}
+			lastBlock = height
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-blockHeadersChan:
+			if !ok {
+				// the subscription is canceled when the indexer falls behind,
+				// keep on indexing by polling the latest height.
+				eis.Logger.Info("new block headers subscription closed, polling the latest height")
+				blockHeadersChan = nil
+				continue
+			}
+			if header, ok := msg.Data.(tmtypes.EventDataNewBlockHeader); ok && header.Header.Height > latestBlock {
+				latestBlock = header.Header.Height
+			}
+		case <-time.After(NewBlockWaitTimeout):
+			status, err := eis.client.Status(ctx)
+			if err != nil {
+				eis.Logger.Error("failed to query the latest height", "error", err.Error())
+				continue
+			}
+			latestBlock = status.SyncInfo.LatestBlockHeight
+		}
+	}
+}
+
+// indexBlock indexes the eth txs of the block at the given height.
+func (eis *EVMIndexerService) indexBlock(ctx context.Context, height int64) error {
+	block, err := eis.client.Block(ctx, &height)
+	if err != nil {
+		return err
+	}
+
+	blockResult, err := eis.client.BlockResults(ctx, &height)
+	if err != nil {
+		return err
+	}
+
+	return eis.txIdxr.IndexBlock(block.Block, blockResult.TxsResults)
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -13,12 +14,15 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/tharsis/ethermint/rpc"
+	"github.com/tharsis/ethermint/rpc/ethereum/backend"
+	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/eth/filters"
 
 	"github.com/tharsis/ethermint/server/config"
+	ethermint "github.com/tharsis/ethermint/types"
 )
 
 // StartJSONRPC starts the JSON-RPC server
-func StartJSONRPC(ctx *server.Context, clientCtx client.Context, tmRPCAddr, tmEndpoint string, config config.Config) (*http.Server, chan struct{}, error) {
+func StartJSONRPC(ctx *server.Context, clientCtx client.Context, tmRPCAddr, tmEndpoint string, config config.Config, indexer ethermint.EVMTxIndexer, filterStore *filters.FilterStore) (*http.Server, chan struct{}, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
 	logger := ctx.Logger.With("module", "geth")
@@ -36,8 +40,17 @@ func StartJSONRPC(ctx *server.Context, clientCtx client.Context, tmRPCAddr, tmEn
 
 	rpcServer := ethrpc.NewServer()
 
+	// the queue of the future nonce eth txs is shared by all the namespaces
+	txQueue := backend.NewTxQueue(int(config.JSONRPC.TxQueueSenderCap), int(config.JSONRPC.TxQueueCap))
+	// the queue processing stops when the server shuts down
+	queueCtx, cancelQueue := context.WithCancel(context.Background())
+	if txQueue.Enabled() {
+		evmBackend := backend.NewEVMBackend(ctx, ctx.Logger, clientCtx, indexer, txQueue)
+		go evmBackend.ProcessTxQueue(queueCtx, time.Second)
+	}
+
 	rpcAPIArr := config.JSONRPC.API
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, rpcAPIArr)
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, indexer, txQueue, filterStore, rpcAPIArr)
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -46,6 +59,7 @@ func StartJSONRPC(ctx *server.Context, clientCtx client.Context, tmRPCAddr, tmEn
 				"namespace", api.Namespace,
 				"service", api.Service,
 			)
+			cancelQueue()
 			return nil, nil, err
 		}
 	}
@@ -65,6 +79,7 @@ func StartJSONRPC(ctx *server.Context, clientCtx client.Context, tmRPCAddr, tmEn
 		WriteTimeout: config.JSONRPC.HTTPTimeout,
 		IdleTimeout:  config.JSONRPC.HTTPIdleTimeout,
 	}
+	httpSrv.RegisterOnShutdown(cancelQueue)
 	httpSrvDone := make(chan struct{}, 1)
 
 	errCh := make(chan error)
@@ -84,6 +99,7 @@ func StartJSONRPC(ctx *server.Context, clientCtx client.Context, tmRPCAddr, tmEn
 	select {
 	case err := <-errCh:
 		ctx.Logger.Error("failed to boot JSON-RPC server", "error", err.Error())
+		cancelQueue()
 		return nil, nil, err
 	case <-time.After(types.ServerStartTime): // assume JSON RPC server started successfully
 	}
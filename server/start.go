@@ -41,9 +41,12 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/tharsis/ethermint/indexer"
 	ethdebug "github.com/tharsis/ethermint/rpc/ethereum/namespaces/debug"
+	"github.com/tharsis/ethermint/rpc/ethereum/namespaces/eth/filters"
 	"github.com/tharsis/ethermint/server/config"
 	srvflags "github.com/tharsis/ethermint/server/flags"
+	ethermint "github.com/tharsis/ethermint/types"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -164,6 +167,17 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Duration(srvflags.JSONRPCHTTPIdleTimeout, config.DefaultHTTPIdleTimeout, "Sets a idle timeout for json-rpc http server (0=infinite)")
 	cmd.Flags().Int32(srvflags.JSONRPCLogsCap, config.DefaultLogsCap, "Sets the max number of results can be returned from single `eth_getLogs` query")
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
+	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Int32(srvflags.JSONRPCTxQueueSenderCap, config.DefaultTxQueueSenderCap, "Sets the max number of future nonce eth txs queued for a sender (0=disabled)")
+	cmd.Flags().Int32(srvflags.JSONRPCTxQueueCap, config.DefaultTxQueueCap, "Sets the max number of future nonce eth txs queued in total (0=disabled)")
+	cmd.Flags().Uint64(srvflags.JSONRPCPriceBump, config.DefaultPriceBump, "Sets the min effective tip increase in percent for an eth tx to replace a tx with the same nonce")
+	cmd.Flags().Bool(srvflags.JSONRPCPersistFilters, false, "Persist the json-rpc polling filters across the node restarts")
+	cmd.Flags().Int32(srvflags.JSONRPCWsSendQueueSize, config.DefaultWsSendQueueSize, "Sets the max number of subscription notifications buffered per websocket connection")
+	cmd.Flags().String(srvflags.JSONRPCWsSlowConsumer, config.DefaultWsSlowConsumerPolicy, "Sets the policy for the websocket clients whose send queue is full (drop|disconnect)")
+	cmd.Flags().Int32(srvflags.JSONRPCWsCosmosEvents, config.DefaultWsCosmosEventsCap, "Sets the max number of distinct queries of the cosmosEvents websocket subscriptions in total (0=disabled)")
+	cmd.Flags().Int32(srvflags.JSONRPCWsCosmosConnCap, config.DefaultWsCosmosEventsConnCap, "Sets the max number of distinct queries of the cosmosEvents websocket subscriptions per connection")
+	cmd.Flags().Int32(srvflags.JSONRPCTraceChunkSize, config.DefaultTraceBlockChunkSize, "Sets the max number of eth txs traced by a single trace block query")
+	cmd.Flags().Int32(srvflags.JSONRPCTraceReplayCap, config.DefaultTraceBlockReplayCap, "Sets the max number of eth txs replayed in total by the chunks of a block trace")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)")
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")
@@ -333,6 +347,35 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator ty
 		app.RegisterTendermintService(clientCtx)
 	}
 
+	var idxer ethermint.EVMTxIndexer
+	if config.JSONRPC.Enable && config.JSONRPC.EnableIndexer {
+		idxDB, err := OpenIndexerDB(home)
+		if err != nil {
+			logger.Error("failed to open evm indexer DB", "error", err.Error())
+			return err
+		}
+		defer func() {
+			if err := idxDB.Close(); err != nil {
+				logger.Error("error closing evm indexer db", "error", err.Error())
+			}
+		}()
+
+		idxLogger := ctx.Logger.With("indexer", "evm")
+		idxer = indexer.NewKVIndexer(idxDB, idxLogger, clientCtx)
+		indexerService := NewEVMIndexerService(idxer, clientCtx.Client)
+		indexerService.SetLogger(idxLogger)
+
+		if err := indexerService.Start(); err != nil {
+			logger.Error("failed to start evm indexer service", "error", err.Error())
+			return err
+		}
+		defer func() {
+			if indexerService.IsRunning() {
+				_ = indexerService.Stop()
+			}
+		}()
+	}
+
 	var apiSrv *api.Server
 	if config.API.Enable {
 		genDoc, err := genDocProvider()
@@ -428,9 +471,24 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, appCreator ty
 
 		clientCtx := clientCtx.WithChainID(genDoc.ChainID)
 
+		var filterStore *filters.FilterStore
+		if config.JSONRPC.PersistFilters {
+			filterDB, err := OpenFilterDB(home)
+			if err != nil {
+				logger.Error("failed to open json-rpc filter DB", "error", err.Error())
+				return err
+			}
+			defer func() {
+				if err := filterDB.Close(); err != nil {
+					logger.Error("error closing json-rpc filter db", "error", err.Error())
+				}
+			}()
+			filterStore = filters.NewFilterStore(filterDB)
+		}
+
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, config)
+		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, config, idxer, filterStore)
 		if err != nil {
 			return err
 		}
@@ -485,6 +543,18 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return sdk.NewLevelDB("application", dataDir)
 }
 
+// OpenIndexerDB opens the custom eth tx indexer db in the node data directory
+func OpenIndexerDB(rootDir string) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return sdk.NewLevelDB("evmindexer", dataDir)
+}
+
+// OpenFilterDB opens the db of the persisted json-rpc filters
+func OpenFilterDB(rootDir string) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return sdk.NewLevelDB("jsonrpcfilters", dataDir)
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
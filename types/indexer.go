@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// EVMTxIndexer defines the interface of the custom eth tx indexer.
+type EVMTxIndexer interface {
+	// LastIndexedBlock returns the last block height indexed, it returns -1 if
+	// the indexer db is empty.
+	LastIndexedBlock() (int64, error)
+	// FirstIndexedBlock returns the first block height indexed, it returns -1
+	// if the indexer db is empty.
+	FirstIndexedBlock() (int64, error)
+	// IndexBlock indexes all the eth txs in the block with the results of the
+	// block execution.
+	IndexBlock(*tmtypes.Block, []*abci.ResponseDeliverTx) error
+	// GetByTxHash returns the indexed result of the eth tx with the given hash.
+	GetByTxHash(common.Hash) (*TxResult, error)
+	// GetByBlockAndIndex returns the indexed result of the eth tx at the given
+	// index in the block.
+	GetByBlockAndIndex(int64, int32) (*TxResult, error)
+	// GetLogs returns the logs of the blocks in the given range matching the
+	// addresses and topics criteria, it fails if more logs than the limit
+	// match.
+	GetLogs(fromBlock, toBlock int64, addresses []common.Address, topics [][]common.Hash, limit int) ([]*ethtypes.Log, error)
+}
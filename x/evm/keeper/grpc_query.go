@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
+	"container/heap"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
@@ -22,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
 	ethermint "github.com/tharsis/ethermint/types"
@@ -35,6 +39,16 @@ const (
 	defaultTraceTimeout = 5 * time.Second
 )
 
+// nativeTracerOptions are the tracer config options supported by the native tracers of go-ethereum v1.10.26. The
+// other options, e.g. withLog of the callTracer or diffMode of the prestateTracer, are ignored by these tracers so
+// they're rejected.
+var nativeTracerOptions = map[string][]string{
+	"callTracer":     {"onlyTopCall"},
+	"prestateTracer": {},
+	"4byteTracer":    {},
+	"noopTracer":     {},
+}
+
 // Account implements the Query/Account gRPC method
 func (k Keeper) Account(c context.Context, req *types.QueryAccountRequest) (*types.QueryAccountResponse, error) {
 	if req == nil {
@@ -136,8 +150,21 @@ func (k Keeper) Balance(c context.Context, req *types.QueryBalanceRequest) (*typ
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	address := common.HexToAddress(req.Address)
+
+	var balanceInt *big.Int
+	if len(req.PendingTxs) > 0 {
+		cfg, err := k.EVMConfig(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 
-	balanceInt := k.GetBalance(ctx, common.HexToAddress(req.Address))
+		stateDB := statedb.New(ctx, &k, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash())))
+		k.applyPendingTxs(ctx, cfg, stateDB, req.PendingTxs)
+		balanceInt = stateDB.GetBalance(address)
+	} else {
+		balanceInt = k.GetBalance(ctx, address)
+	}
 
 	return &types.QueryBalanceResponse{
 		Balance: balanceInt.String(),
@@ -227,8 +254,33 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	if len(req.Overrides) > 0 {
+		var overrides types.StateOverride
+		if err := json.Unmarshal(req.Overrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cfg.Overrides = &overrides
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
 	// ApplyMessageWithConfig expect correct nonce set in msg
 	nonce := k.GetNonce(ctx, args.GetFrom())
+	if len(req.PendingTxs) > 0 {
+		// execute the message on top of the pending state
+		stateDB := statedb.New(ctx, &k, txConfig)
+		k.applyPendingTxs(ctx, cfg, stateDB, req.PendingTxs)
+		if err := cfg.Overrides.Apply(stateDB); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cfg.StateDB = stateDB
+		nonce = stateDB.GetNonce(args.GetFrom())
+	}
+	if cfg.Overrides != nil {
+		if account, ok := (*cfg.Overrides)[args.GetFrom()]; ok && account.Nonce != nil {
+			nonce = uint64(*account.Nonce)
+		}
+	}
 	args.Nonce = (*hexutil.Uint64)(&nonce)
 
 	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
@@ -236,8 +288,6 @@ func (k Keeper) EthCall(c context.Context, req *types.EthCallRequest) (*types.Ms
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
-
 	// pass false to not commit StateDB
 	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
 	if err != nil {
@@ -285,12 +335,28 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		}
 	}
 
-	// TODO: Recap the highest gas limit with account's available balance.
-
 	// Recap the highest gas allowance with specified gascap.
 	if req.GasCap != 0 && hi > req.GasCap {
 		hi = req.GasCap
 	}
+
+	// Recap the highest gas limit with account's available balance.
+	var fundsAllowance *big.Int
+	if feeCap := args.GetFeeCap(); feeCap != nil && feeCap.Sign() > 0 && args.From != nil {
+		available := k.GetBalance(ctx, *args.From)
+		if args.Value != nil {
+			if args.Value.ToInt().Cmp(available) >= 0 {
+				return nil, status.Error(codes.InvalidArgument, core.ErrInsufficientFundsForTransfer.Error())
+			}
+			available = new(big.Int).Sub(available, args.Value.ToInt())
+		}
+		fundsAllowance = new(big.Int).Quo(available, feeCap)
+		if fundsAllowance.IsUint64() && hi > fundsAllowance.Uint64() {
+			hi = fundsAllowance.Uint64()
+		} else {
+			fundsAllowance = nil
+		}
+	}
 	cap = hi
 
 	cfg, err := k.EVMConfig(ctx)
@@ -304,6 +370,9 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
 
+	// refund is the gas refunded by the last execution
+	var refund uint64
+
 	// Create a helper to check if a gas allowance results in an executable transaction
 	executable := func(gas uint64) (vmerror bool, rsp *types.MsgEthereumTxResponse, err error) {
 		args.Gas = (*hexutil.Uint64)(&gas)
@@ -314,7 +383,7 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		}
 
 		// pass false to not commit StateDB
-		rsp, err = k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
+		rsp, refund, err = k.applyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
 		if err != nil {
 			if errors.Is(err, core.ErrIntrinsicGas) {
 				return true, nil, nil // Special case, raise gas limit
@@ -324,30 +393,214 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		return len(rsp.VmError) > 0, rsp, nil
 	}
 
+	// Execute at the highest allowance first, so the failing messages are
+	// rejected without searching.
+	failed, result, err := executable(hi)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if failed {
+		if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
+			// the revert data is returned in the response since it doesn't survive the query errors
+			if result.VmError == vm.ErrExecutionReverted.Error() {
+				return &types.EstimateGasResponse{Ret: result.Ret, VmError: result.VmError}, nil
+			}
+			return nil, status.Error(codes.Internal, result.VmError)
+		}
+		// The gas allowance is capped by the sender's balance
+		if fundsAllowance != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf(
+				"%s: address %s, gas allowance %d from balance", core.ErrInsufficientFunds, args.GetFrom(), cap,
+			))
+		}
+		// Otherwise, the specified gas cap is too low
+		return nil, status.Error(codes.Internal, fmt.Sprintf("gas required exceeds allowance (%d)", cap))
+	}
+
+	// The gas used is a lower bound of the gas limit, the execution very likely
+	// succeeds with the gas needed by the first execution plus the gas withheld
+	// by the 63/64 rule of the nested calls, try it before searching.
+	if result.GasUsed > lo+1 {
+		lo = result.GasUsed - 1
+	}
+	optimisticGas := (result.GasUsed + refund + ethparams.CallStipend) * 64 / 63
+	if optimisticGas > lo && optimisticGas < hi {
+		failed, _, err := executable(optimisticGas)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if failed {
+			lo = optimisticGas
+		} else {
+			hi = optimisticGas
+		}
+	}
+
 	// Execute the binary search and hone in on an executable gas limit
 	hi, err = types.BinSearch(lo, hi, executable)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	return &types.EstimateGasResponse{Gas: hi}, nil
+}
+
+// CreateAccessList implements eth_createAccessList rpc api. It executes the
+// message repeatedly with an access list tracer until the generated access
+// list stops changing, and returns it together with the gas used.
+func (k Keeper) CreateAccessList(c context.Context, req *types.EthCallRequest) (*types.CreateAccessListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	err := json.Unmarshal(req.Args, &args)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	from := args.GetFrom()
+	nonce := k.GetNonce(ctx, from)
+	args.Nonce = (*hexutil.Uint64)(&nonce)
 
-	// Reject the transaction as invalid if it still fails at the highest allowance
-	if hi == cap {
-		failed, result, err := executable(hi)
+	// the recipient of a contract creation is the created contract itself
+	var to common.Address
+	if args.To != nil {
+		to = *args.To
+	} else {
+		to = crypto.CreateAddress(from, nonce)
+	}
+
+	// precompiles are warm by default, so they don't need to be in the access list
+	rules := cfg.ChainConfig.Rules(big.NewInt(ctx.BlockHeight()), cfg.ChainConfig.MergeForkBlock != nil)
+	precompiles := vm.ActivePrecompiles(rules)
+
+	var prevAccessList ethtypes.AccessList
+	if args.AccessList != nil {
+		prevAccessList = *args.AccessList
+	}
+	prevTracer := logger.NewAccessListTracer(prevAccessList, from, to, precompiles)
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+
+	for {
+		// retrieve the current access list and re-run the message with it
+		accessList := prevTracer.AccessList()
+		args.AccessList = &accessList
+
+		msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		tracer := logger.NewAccessListTracer(accessList, from, to, precompiles)
+
+		// pass false to not commit StateDB
+		res, err := k.ApplyMessageWithConfig(ctx, msg, tracer, false, cfg, txConfig)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
-		if failed {
-			if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
-				if result.VmError == vm.ErrExecutionReverted.Error() {
-					return nil, types.NewExecErrorWithReason(result.Ret)
-				}
-				return nil, status.Error(codes.Internal, result.VmError)
+
+		if tracer.Equal(prevTracer) {
+			return &types.CreateAccessListResponse{
+				AccessList: types.NewAccessList(&accessList),
+				GasUsed:    res.GasUsed,
+				VmError:    res.VmError,
+			}, nil
+		}
+		prevTracer = tracer
+	}
+}
+
+// SimulateCalls implements the eth_simulateV1 rpc api, the calls of all the
+// blocks are executed in order on the same StateDB which is never committed.
+// The number of blocks and calls is bounded, and all the calls share the gas
+// cap of the request: the gas limit of a call is capped by the gas left by the
+// previous ones.
+func (k Keeper) SimulateCalls(c context.Context, req *types.SimulateCallsRequest) (*types.SimulateCallsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var blocks []types.SimBlock
+	if err := json.Unmarshal(req.Blocks, &blocks); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(blocks) > types.MaxSimulateBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "too many blocks, max: %d", types.MaxSimulateBlocks)
+	}
+	calls := 0
+	for _, block := range blocks {
+		calls += len(block.Calls)
+	}
+	if calls > types.MaxSimulateCalls {
+		return nil, status.Errorf(codes.InvalidArgument, "too many calls, max: %d", types.MaxSimulateCalls)
+	}
+
+	cfg, err := k.EVMConfig(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// the gas left to the calls, zero means no cap
+	gasPool := req.GasCap
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	stateDB := statedb.New(ctx, &k, txConfig)
+	cfg.StateDB = stateDB
+
+	var results []*types.MsgEthereumTxResponse
+	for _, block := range blocks {
+		if len(block.BlockOverrides) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "block overrides are not supported")
+		}
+
+		if err := block.StateOverrides.Apply(stateDB); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		for _, args := range block.Calls {
+			// ApplyMessageWithConfig expect correct nonce set in msg
+			nonce := stateDB.GetNonce(args.GetFrom())
+			args.Nonce = (*hexutil.Uint64)(&nonce)
+
+			if req.GasCap != 0 && gasPool == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "gas cap of %d exhausted by the calls", req.GasCap)
+			}
+			msg, err := args.ToMessage(gasPool, cfg.BaseFee)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+
+			txConfig.TxIndex = uint(len(results))
+			res, err := k.ApplyMessageWithConfig(ctx, msg, nil, false, cfg, txConfig)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
 			}
-			// Otherwise, the specified gas cap is too low
-			return nil, status.Error(codes.Internal, fmt.Sprintf("gas required exceeds allowance (%d)", cap))
+
+			if req.GasCap != 0 {
+				gasPool -= res.GasUsed
+			}
+
+			// increment the nonce like the ante handler, so the contracts created
+			// by the next calls of the sender get a different address
+			stateDB.SetNonce(msg.From(), nonce+1)
+
+			txConfig.LogIndex += uint(len(res.Logs))
+			results = append(results, res)
 		}
 	}
-	return &types.EstimateGasResponse{Gas: hi}, nil
+
+	return &types.SimulateCallsResponse{Results: results}, nil
 }
 
 // TraceTx configures a new tracer according to the provided configuration, and
@@ -362,17 +615,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
 
-	// minus one to get the context of block beginning
-	contextHeight := req.BlockNumber - 1
-	if contextHeight < 1 {
-		// 0 is a special value in `ContextWithHeight`
-		contextHeight = 1
-	}
-
-	ctx := sdk.UnwrapSDKContext(c)
-	ctx = ctx.WithBlockHeight(contextHeight)
-	ctx = ctx.WithBlockTime(req.BlockTime)
-	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	ctx := blockBeginningContext(c, req.BlockNumber, req.BlockTime, req.BlockHash)
 
 	cfg, err := k.EVMConfig(ctx)
 	if err != nil {
@@ -381,20 +624,7 @@ func (k Keeper) TraceTx(c context.Context, req *types.QueryTraceTxRequest) (*typ
 	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
-	for i, tx := range req.Predecessors {
-		ethTx := tx.AsTransaction()
-		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
-		if err != nil {
-			continue
-		}
-		txConfig.TxHash = ethTx.Hash()
-		txConfig.TxIndex = uint(i)
-		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, txConfig)
-		if err != nil {
-			continue
-		}
-		txConfig.LogIndex += uint(len(rsp.Logs))
-	}
+	k.applyPredecessors(ctx, cfg, signer, &txConfig, req.Predecessors)
 
 	tx := req.Msg.AsTransaction()
 	txConfig.TxHash = tx.Hash()
@@ -427,17 +657,7 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
 	}
 
-	// minus one to get the context of block beginning
-	contextHeight := req.BlockNumber - 1
-	if contextHeight < 1 {
-		// 0 is a special value in `ContextWithHeight`
-		contextHeight = 1
-	}
-
-	ctx := sdk.UnwrapSDKContext(c)
-	ctx = ctx.WithBlockHeight(contextHeight)
-	ctx = ctx.WithBlockTime(req.BlockTime)
-	ctx = ctx.WithHeaderHash(common.Hex2Bytes(req.BlockHash))
+	ctx := blockBeginningContext(c, req.BlockNumber, req.BlockTime, req.BlockHash)
 
 	cfg, err := k.EVMConfig(ctx)
 	if err != nil {
@@ -448,14 +668,19 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	results := make([]*types.TxTraceResult, 0, txsLength)
 
 	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	// the txs of the previous chunks of a large block are replayed first
+	k.applyPredecessors(ctx, cfg, signer, &txConfig, req.Predecessors)
+
 	for i, tx := range req.Txs {
 		result := types.TxTraceResult{}
 		ethTx := tx.AsTransaction()
 		txConfig.TxHash = ethTx.Hash()
-		txConfig.TxIndex = uint(i)
+		txConfig.TxIndex = uint(len(req.Predecessors) + i)
 		traceResult, logIndex, err := k.traceTx(ctx, cfg, txConfig, signer, ethTx, req.TraceConfig, true)
 		if err != nil {
+			// keep one result per tx so the results of the chunks line up with the block txs
 			result.Error = err.Error()
+			results = append(results, &result)
 			continue
 		}
 		txConfig.LogIndex = logIndex
@@ -473,6 +698,269 @@ func (k Keeper) TraceBlock(c context.Context, req *types.QueryTraceBlockRequest)
 	}, nil
 }
 
+// applyPredecessors executes and commits the predecessor txs of the traced txs without
+// tracing, the tx index of the tx config is left at the last predecessor and the log
+// index is moved past their logs. The txs that fail are skipped.
+func (k *Keeper) applyPredecessors(
+	ctx sdk.Context,
+	cfg *types.EVMConfig,
+	signer ethtypes.Signer,
+	txConfig *statedb.TxConfig,
+	txs []*types.MsgEthereumTx,
+) {
+	for i, tx := range txs {
+		ethTx := tx.AsTransaction()
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			continue
+		}
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i)
+		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), true, cfg, *txConfig)
+		if err != nil {
+			continue
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+	}
+}
+
+// TraceCall configures a new tracer according to the provided configuration, and
+// executes the call on top of the state of the queried block, with the state and
+// block overrides applied. The return value will be tracer dependent.
+func (k Keeper) TraceCall(c context.Context, req *types.QueryTraceCallRequest) (*types.QueryTraceCallResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.TraceConfig != nil && req.TraceConfig.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "output limit cannot be negative, got %d", req.TraceConfig.Limit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var args types.TransactionArgs
+	if err := json.Unmarshal(req.Args, &args); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cfg, err := k.EVMConfig(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load evm config: %s", err.Error())
+	}
+
+	if len(req.StateOverrides) > 0 {
+		var overrides types.StateOverride
+		if err := json.Unmarshal(req.StateOverrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cfg.Overrides = &overrides
+	}
+	if len(req.BlockOverrides) > 0 {
+		var overrides types.BlockOverrides
+		if err := json.Unmarshal(req.BlockOverrides, &overrides); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cfg.BlockOverrides = &overrides
+	}
+
+	// ApplyMessageWithConfig expect correct nonce set in msg
+	nonce := k.GetNonce(ctx, args.GetFrom())
+	if cfg.Overrides != nil {
+		if account, ok := (*cfg.Overrides)[args.GetFrom()]; ok && account.Nonce != nil {
+			nonce = uint64(*account.Nonce)
+		}
+	}
+	args.Nonce = (*hexutil.Uint64)(&nonce)
+
+	msg, err := args.ToMessage(req.GasCap, cfg.BaseFee)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash()))
+	result, _, err := k.traceMsg(ctx, cfg, txConfig, msg, req.TraceConfig, false)
+	if err != nil {
+		// error will be returned with detail status from traceMsg
+		return nil, err
+	}
+
+	resultData, err := json.Marshal(result)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTraceCallResponse{
+		Data: resultData,
+	}, nil
+}
+
+// IntermediateRoots executes the transactions of a block on top of the state of the
+// previous block and returns the state root after each transaction. The roots are chained
+// from the empty hash, each one is the keccak256 hash of the previous root and of the
+// `StateDB.DirtyHash` of the EVM state changes of the transaction, the fees and nonces
+// handled by the ante handler are not included. The transactions that fail to execute
+// don't change the root.
+func (k Keeper) IntermediateRoots(c context.Context, req *types.QueryIntermediateRootsRequest) (*types.QueryIntermediateRootsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := blockBeginningContext(c, req.BlockNumber, req.BlockTime, req.BlockHash)
+
+	cfg, err := k.EVMConfig(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	roots := make([]string, 0, len(req.Txs))
+	var root common.Hash
+	for i, tx := range req.Txs {
+		ethTx := tx.AsTransaction()
+		msg, err := ethTx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		txConfig.TxHash = ethTx.Hash()
+		txConfig.TxIndex = uint(i)
+
+		// the message is executed on a StateDB kept here, so its changes are hashed before they are committed
+		stateDB := statedb.New(ctx, &k, txConfig)
+		txCfg := *cfg
+		txCfg.StateDB = stateDB
+		rsp, err := k.ApplyMessageWithConfig(ctx, msg, types.NewNoOpTracer(), false, &txCfg, txConfig)
+		if err != nil {
+			roots = append(roots, root.Hex())
+			continue
+		}
+
+		root = crypto.Keccak256Hash(root.Bytes(), stateDB.DirtyHash().Bytes())
+		if err := stateDB.Commit(); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		txConfig.LogIndex += uint(len(rsp.Logs))
+		roots = append(roots, root.Hex())
+	}
+
+	return &types.QueryIntermediateRootsResponse{
+		Roots: roots,
+	}, nil
+}
+
+// StorageRangeAt returns a range of the storage of a contract after the predecessor
+// transactions of a block are executed on top of the state of the previous block. The
+// storage entries are sorted by the keccak256 hash of their keys, like in the storage
+// trie, the range starts at the key start hash and holds up to max result entries.
+func (k Keeper) StorageRangeAt(c context.Context, req *types.QueryStorageRangeAtRequest) (*types.QueryStorageRangeAtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ethermint.ValidateAddress(req.Address); err != nil {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			types.ErrZeroAddress.Error(),
+		)
+	}
+
+	ctx := blockBeginningContext(c, req.BlockNumber, req.BlockTime, req.BlockHash)
+
+	cfg, err := k.EVMConfig(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load evm config")
+	}
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+
+	txConfig := statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes()))
+	k.applyPredecessors(ctx, cfg, signer, &txConfig, req.Predecessors)
+
+	// only the max result entries with the smallest hashes after key start are kept, plus one
+	// for the next key
+	limit := req.MaxResult + 1
+	if limit == 0 {
+		limit = req.MaxResult
+	}
+
+	keyStart := common.HexToHash(req.KeyStart)
+	entries := &storageHeap{}
+	k.ForEachStorage(ctx, common.HexToAddress(req.Address), func(key, value common.Hash) bool {
+		hash := crypto.Keccak256Hash(key.Bytes())
+		if bytes.Compare(hash.Bytes(), keyStart.Bytes()) < 0 {
+			return true
+		}
+
+		entry := storageEntry{hash: hash, state: types.NewState(key, value)}
+		switch {
+		case uint64(entries.Len()) < limit:
+			heap.Push(entries, entry)
+		case bytes.Compare(hash.Bytes(), (*entries)[0].hash.Bytes()) < 0:
+			// replaces the largest kept hash
+			(*entries)[0] = entry
+			heap.Fix(entries, 0)
+		}
+		return true
+	})
+	sort.Slice(*entries, func(i, j int) bool {
+		return bytes.Compare((*entries)[i].hash.Bytes(), (*entries)[j].hash.Bytes()) < 0
+	})
+
+	res := &types.QueryStorageRangeAtResponse{
+		Storage: []types.State{},
+	}
+	for i, entry := range *entries {
+		if uint64(i) == req.MaxResult {
+			res.NextKey = entry.hash.Hex()
+			break
+		}
+		res.Storage = append(res.Storage, entry.state)
+	}
+
+	return res, nil
+}
+
+// storageEntry is a storage slot of a contract indexed by the keccak256 hash of its key.
+type storageEntry struct {
+	hash  common.Hash
+	state types.State
+}
+
+// storageHeap is a max-heap of storage entries ordered by hash, it implements heap.Interface.
+type storageHeap []storageEntry
+
+func (h storageHeap) Len() int { return len(h) }
+
+func (h storageHeap) Less(i, j int) bool {
+	return bytes.Compare(h[i].hash.Bytes(), h[j].hash.Bytes()) > 0
+}
+
+func (h storageHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *storageHeap) Push(x interface{}) { *h = append(*h, x.(storageEntry)) }
+
+func (h *storageHeap) Pop() interface{} {
+	old := *h
+	entry := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return entry
+}
+
+// blockBeginningContext returns the context at the beginning of the block with the given
+// number, time and hash, on top of the state of the previous block.
+func blockBeginningContext(c context.Context, blockNumber int64, blockTime time.Time, blockHash string) sdk.Context {
+	// minus one to get the context of block beginning
+	contextHeight := blockNumber - 1
+	if contextHeight < 1 {
+		// 0 is a special value in `ContextWithHeight`
+		contextHeight = 1
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ctx = ctx.WithBlockHeight(contextHeight)
+	ctx = ctx.WithBlockTime(blockTime)
+	return ctx.WithHeaderHash(common.Hex2Bytes(blockHash))
+}
+
 // traceTx do trace on one transaction, it returns a tuple: (traceResult, nextLogIndex, error).
 func (k *Keeper) traceTx(
 	ctx sdk.Context,
@@ -483,18 +971,30 @@ func (k *Keeper) traceTx(
 	traceConfig *types.TraceConfig,
 	commitMessage bool,
 ) (*interface{}, uint, error) {
-	// Assemble the structured logger or the JavaScript tracer
+	msg, err := tx.AsMessage(signer, cfg.BaseFee)
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return k.traceMsg(ctx, cfg, txConfig, msg, traceConfig, commitMessage)
+}
+
+// traceMsg do trace on one message, it returns a tuple: (traceResult, nextLogIndex, error).
+func (k *Keeper) traceMsg(
+	ctx sdk.Context,
+	cfg *types.EVMConfig,
+	txConfig statedb.TxConfig,
+	msg core.Message,
+	traceConfig *types.TraceConfig,
+	commitMessage bool,
+) (*interface{}, uint, error) {
+	// Assemble the structured logger or the native or JavaScript tracer
 	var (
 		tracer    vm.EVMLogger
 		overrides *ethparams.ChainConfig
 		err       error
 	)
 
-	msg, err := tx.AsMessage(signer, cfg.BaseFee)
-	if err != nil {
-		return nil, 0, status.Error(codes.Internal, err.Error())
-	}
-
 	if traceConfig != nil && traceConfig.Overrides != nil {
 		overrides = traceConfig.Overrides.EthereumConfig(cfg.ChainConfig.ChainID)
 	}
@@ -517,8 +1017,17 @@ func (k *Keeper) traceTx(
 			TxHash:    txConfig.TxHash,
 		}
 
-		// Construct the JavaScript tracer to execute with
-		if tracer, err = tracers.New(traceConfig.Tracer, tCtx); err != nil {
+		var tracerConfig json.RawMessage
+		if traceConfig.TracerJsonConfig != "" {
+			tracerConfig = json.RawMessage(traceConfig.TracerJsonConfig)
+		}
+
+		if err := validateTracerConfig(traceConfig.Tracer, tracerConfig); err != nil {
+			return nil, 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		// Construct the native or JavaScript tracer to execute with
+		if tracer, err = tracers.New(traceConfig.Tracer, tCtx, tracerConfig); err != nil {
 			return nil, 0, status.Error(codes.Internal, err.Error())
 		}
 
@@ -583,3 +1092,75 @@ func (k *Keeper) traceTx(
 
 	return &result, txConfig.LogIndex + uint(len(res.Logs)), nil
 }
+
+// validateTracerConfig returns an error if the tracer is a native tracer which doesn't support an option of the
+// tracer config, the config of the JavaScript tracers is passed to their setup function as is.
+func validateTracerConfig(tracer string, tracerConfig json.RawMessage) error {
+	supported, native := nativeTracerOptions[tracer]
+	if !native || len(tracerConfig) == 0 {
+		return nil
+	}
+
+	var options map[string]json.RawMessage
+	if err := json.Unmarshal(tracerConfig, &options); err != nil {
+		return fmt.Errorf("invalid tracer config: %w", err)
+	}
+
+	for option := range options {
+		found := false
+		for _, opt := range supported {
+			if opt == option {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("the %s option is not supported by the %s", option, tracer)
+		}
+	}
+	return nil
+}
+
+// applyPendingTxs replays the pending eth txs on the StateDB to build the pending
+// state. Like in the ante handler, the fees are deducted and the nonce of the
+// sender is incremented, the txs with an invalid nonce or which the sender can't
+// afford are skipped.
+func (k *Keeper) applyPendingTxs(ctx sdk.Context, cfg *types.EVMConfig, stateDB *statedb.StateDB, txs []*types.MsgEthereumTx) {
+	signer := ethtypes.MakeSigner(cfg.ChainConfig, big.NewInt(ctx.BlockHeight()))
+	blockHash := common.BytesToHash(ctx.HeaderHash())
+
+	pendingCfg := *cfg
+	pendingCfg.Overrides = nil
+	pendingCfg.StateDB = stateDB
+
+	for i, tx := range txs {
+		msg, err := tx.AsMessage(signer, cfg.BaseFee)
+		if err != nil {
+			k.Logger(ctx).Debug("invalid pending tx", "hash", tx.Hash, "error", err.Error())
+			continue
+		}
+
+		from := msg.From()
+		if msg.Nonce() != stateDB.GetNonce(from) {
+			continue
+		}
+
+		fees := new(big.Int).Mul(msg.GasPrice(), new(big.Int).SetUint64(msg.Gas()))
+		if stateDB.GetBalance(from).Cmp(new(big.Int).Add(fees, msg.Value())) < 0 {
+			continue
+		}
+		stateDB.SubBalance(from, fees)
+		stateDB.SetNonce(from, msg.Nonce()+1)
+
+		txConfig := statedb.NewTxConfig(blockHash, common.HexToHash(tx.Hash), uint(i), 0)
+		res, err := k.ApplyMessageWithConfig(ctx, msg, nil, false, &pendingCfg, txConfig)
+		if err != nil {
+			k.Logger(ctx).Debug("failed to apply pending tx", "hash", tx.Hash, "error", err.Error())
+			continue
+		}
+
+		// refund the leftover gas
+		leftoverGas := new(big.Int).SetUint64(msg.Gas() - res.GasUsed)
+		stateDB.AddBalance(from, new(big.Int).Mul(msg.GasPrice(), leftoverGas))
+	}
+}
@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/tharsis/ethermint/x/evm/statedb"
@@ -539,6 +542,15 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 			suite.Require().NoError(err)
 			args = types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&transferData)}
 		}, true, 51880, false},
+		// should success, the balance covers the gas at the gas price
+		{"gas price within balance", func() {
+			args = types.TransactionArgs{To: &common.Address{}, From: &suite.address, GasPrice: (*hexutil.Big)(big.NewInt(1))}
+		}, true, 21000, false},
+		// should fail, the gas allowance from the balance is lower than the intrinsic gas
+		{"gas price exceeds balance", func() {
+			gasPrice := new(big.Int).Exp(big.NewInt(10), big.NewInt(40), nil)
+			args = types.TransactionArgs{To: &common.Address{}, From: &suite.address, GasPrice: (*hexutil.Big)(gasPrice)}
+		}, false, 0, false},
 
 		// repeated tests with enableFeemarket
 		{"default args w/ enableFeemarket", func() {
@@ -601,6 +613,334 @@ func (suite *KeeperTestSuite) TestEstimateGas() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestEstimateGasRevert() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdk.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+
+	// the transfer exceeds the balance of the sender
+	transferData, err := types.ERC20Contract.ABI.Pack("transfer", common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), sdk.NewIntWithDecimal(2000, 18).BigInt())
+	suite.Require().NoError(err)
+	args, err := json.Marshal(&types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&transferData)})
+	suite.Require().NoError(err)
+
+	rsp, err := suite.queryClient.EstimateGas(sdk.WrapSDKContext(suite.ctx), &types.EthCallRequest{
+		Args:   args,
+		GasCap: 25_000_000,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(rsp.Failed())
+	suite.Require().Zero(rsp.Gas)
+
+	// the revert data is returned with the response
+	reason, err := types.UnpackRevertReason(rsp.Revert())
+	suite.Require().NoError(err)
+	suite.Require().Equal("MATH_SUB_UNDERFLOW", reason)
+}
+
+func (suite *KeeperTestSuite) TestCreateAccessList() {
+	var (
+		args         types.TransactionArgs
+		contractAddr common.Address
+	)
+	testCases := []struct {
+		msg         string
+		malleate    func()
+		expVMError  bool
+		expAccesses int
+		expSlots    int
+	}{
+		{"transfer", func() {
+			args = types.TransactionArgs{To: &common.Address{}}
+		}, false, 0, 0},
+		// the vm error is returned along with the access list
+		{"not enough balance", func() {
+			args = types.TransactionArgs{To: &common.Address{}, Value: (*hexutil.Big)(big.NewInt(100))}
+		}, true, 0, 0},
+		// the sender and recipient balance slots of the token contract are accessed
+		{"erc20 transfer", func() {
+			contractAddr = suite.DeployTestContract(suite.T(), suite.address, sdk.NewIntWithDecimal(1000, 18).BigInt())
+			suite.Commit()
+			transferData, err := types.ERC20Contract.ABI.Pack("transfer", common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), big.NewInt(1000))
+			suite.Require().NoError(err)
+			args = types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&transferData)}
+		}, false, 1, 2},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			args, err := json.Marshal(&args)
+			suite.Require().NoError(err)
+			req := types.EthCallRequest{
+				Args:   args,
+				GasCap: 25_000_000,
+			}
+
+			rsp, err := suite.queryClient.CreateAccessList(sdk.WrapSDKContext(suite.ctx), &req)
+			suite.Require().NoError(err)
+			if tc.expVMError {
+				suite.Require().NotEmpty(rsp.VmError)
+			} else {
+				suite.Require().Empty(rsp.VmError)
+			}
+			suite.Require().Len(rsp.AccessList, tc.expAccesses)
+			if tc.expAccesses > 0 {
+				suite.Require().Equal(contractAddr.Hex(), rsp.AccessList[0].Address)
+				suite.Require().Len(rsp.AccessList[0].StorageKeys, tc.expSlots)
+			}
+			suite.Require().NotZero(rsp.GasUsed)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSimulateCalls() {
+	var (
+		blocks  []types.SimBlock
+		gasCap  uint64
+		expRets func(results []*types.MsgEthereumTxResponse)
+	)
+
+	supply := big.NewInt(10000)
+	recipient := common.BigToAddress(big.NewInt(1))
+
+	deployCall := func() (types.TransactionArgs, common.Address) {
+		ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, supply)
+		suite.Require().NoError(err)
+		data := append(types.ERC20Contract.Bin, ctorArgs...)
+		contractAddr := crypto.CreateAddress(suite.address, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+		return types.TransactionArgs{From: &suite.address, Data: (*hexutil.Bytes)(&data)}, contractAddr
+	}
+	contractCall := func(contractAddr common.Address, method string, args ...interface{}) types.TransactionArgs {
+		data, err := types.ERC20Contract.ABI.Pack(method, args...)
+		suite.Require().NoError(err)
+		return types.TransactionArgs{From: &suite.address, To: &contractAddr, Data: (*hexutil.Bytes)(&data)}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"calls see the state changes of the previous ones",
+			func() {
+				deploy, contractAddr := deployCall()
+				blocks = []types.SimBlock{
+					{Calls: []types.TransactionArgs{deploy, contractCall(contractAddr, "transfer", recipient, big.NewInt(1000))}},
+					{Calls: []types.TransactionArgs{contractCall(contractAddr, "balanceOf", recipient)}},
+				}
+				expRets = func(results []*types.MsgEthereumTxResponse) {
+					suite.Require().Len(results, 3)
+					for _, res := range results {
+						suite.Require().False(res.Failed(), res.VmError)
+					}
+					suite.Require().Len(results[1].Logs, 1)
+					suite.Require().Equal(common.BigToHash(big.NewInt(1000)).Bytes(), results[2].Ret)
+				}
+			},
+			true,
+		},
+		{
+			"reverted call",
+			func() {
+				deploy, contractAddr := deployCall()
+				blocks = []types.SimBlock{
+					{Calls: []types.TransactionArgs{deploy, contractCall(contractAddr, "transfer", recipient, big.NewInt(20000))}},
+				}
+				expRets = func(results []*types.MsgEthereumTxResponse) {
+					suite.Require().Len(results, 2)
+					suite.Require().False(results[0].Failed())
+					suite.Require().Equal(vm.ErrExecutionReverted.Error(), results[1].VmError)
+					suite.Require().NotEmpty(results[1].Ret)
+				}
+			},
+			true,
+		},
+		{
+			"state overrides of a block",
+			func() {
+				balance := (*hexutil.Big)(big.NewInt(1000))
+				blocks = []types.SimBlock{
+					{
+						StateOverrides: &types.StateOverride{recipient: types.OverrideAccount{Balance: &balance}},
+						Calls: []types.TransactionArgs{
+							{From: &recipient, To: &suite.address, Value: (*hexutil.Big)(big.NewInt(1000))},
+							{From: &recipient, To: &suite.address, Value: (*hexutil.Big)(big.NewInt(1000))},
+						},
+					},
+				}
+				expRets = func(results []*types.MsgEthereumTxResponse) {
+					suite.Require().Len(results, 2)
+					suite.Require().False(results[0].Failed())
+					// the balance is spent by the first call
+					suite.Require().True(results[1].Failed())
+				}
+			},
+			true,
+		},
+		{
+			"block overrides are not supported",
+			func() {
+				blocks = []types.SimBlock{
+					{BlockOverrides: json.RawMessage(`{"number":"0x1"}`)},
+				}
+			},
+			false,
+		},
+		{
+			"too many blocks",
+			func() {
+				blocks = make([]types.SimBlock, types.MaxSimulateBlocks+1)
+			},
+			false,
+		},
+		{
+			"too many calls",
+			func() {
+				blocks = []types.SimBlock{
+					{Calls: make([]types.TransactionArgs, types.MaxSimulateCalls)},
+					{Calls: make([]types.TransactionArgs, 1)},
+				}
+			},
+			false,
+		},
+		{
+			"the gas cap is shared by the calls",
+			func() {
+				gasCap = 30000
+				blocks = []types.SimBlock{
+					{Calls: []types.TransactionArgs{
+						{From: &suite.address, To: &recipient, Value: (*hexutil.Big)(big.NewInt(1))},
+						{From: &suite.address, To: &recipient, Value: (*hexutil.Big)(big.NewInt(1))},
+					}},
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			gasCap = uint64(config.DefaultGasCap)
+			tc.malleate()
+
+			bz, err := json.Marshal(blocks)
+			suite.Require().NoError(err)
+
+			rsp, err := suite.queryClient.SimulateCalls(sdk.WrapSDKContext(suite.ctx), &types.SimulateCallsRequest{
+				Blocks: bz,
+				GasCap: gasCap,
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			expRets(rsp.Results)
+
+			// nothing is committed
+			suite.Require().Zero(suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPendingState() {
+	recipient := common.BigToAddress(big.NewInt(1))
+	gasPrice := big.NewInt(10)
+	fees := new(big.Int).Mul(gasPrice, big.NewInt(21000))
+
+	newPendingTx := func(nonce uint64, to common.Address, amount *big.Int) *types.MsgEthereumTx {
+		chainID := suite.app.EvmKeeper.ChainID()
+		tx := types.NewTx(chainID, nonce, &to, amount, 21000, gasPrice, nil, nil, nil, nil)
+		tx.From = suite.address.Hex()
+		suite.Require().NoError(tx.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+		return tx
+	}
+
+	testCases := []struct {
+		msg          string
+		pendingTxs   func() []*types.MsgEthereumTx
+		expBalance   *big.Int
+		expRecipient *big.Int
+	}{
+		{
+			"no pending txs",
+			func() []*types.MsgEthereumTx { return nil },
+			big.NewInt(1_000_000),
+			big.NewInt(0),
+		},
+		{
+			"pending transfers",
+			func() []*types.MsgEthereumTx {
+				return []*types.MsgEthereumTx{
+					newPendingTx(0, recipient, big.NewInt(1000)),
+					newPendingTx(1, recipient, big.NewInt(2000)),
+				}
+			},
+			new(big.Int).Sub(big.NewInt(1_000_000-3000), new(big.Int).Mul(fees, big.NewInt(2))),
+			big.NewInt(3000),
+		},
+		{
+			"invalid nonce and unaffordable txs are skipped",
+			func() []*types.MsgEthereumTx {
+				return []*types.MsgEthereumTx{
+					newPendingTx(1, recipient, big.NewInt(1000)),
+					newPendingTx(0, recipient, big.NewInt(1_000_000)),
+					newPendingTx(0, recipient, big.NewInt(1000)),
+				}
+			},
+			new(big.Int).Sub(big.NewInt(1_000_000-1000), fees),
+			big.NewInt(1000),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			suite.Require().NoError(suite.app.EvmKeeper.SetBalance(suite.ctx, suite.address, big.NewInt(1_000_000)))
+			pendingTxs := tc.pendingTxs()
+			ctx := sdk.WrapSDKContext(suite.ctx)
+
+			res, err := suite.queryClient.Balance(ctx, &types.QueryBalanceRequest{
+				Address:    suite.address.String(),
+				PendingTxs: pendingTxs,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBalance.String(), res.Balance)
+
+			res, err = suite.queryClient.Balance(ctx, &types.QueryBalanceRequest{
+				Address:    recipient.String(),
+				PendingTxs: pendingTxs,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRecipient.String(), res.Balance)
+
+			// the call from the recipient sees the pending balance
+			args, err := json.Marshal(&types.TransactionArgs{
+				From:  &recipient,
+				To:    &suite.address,
+				Value: (*hexutil.Big)(big.NewInt(1000)),
+			})
+			suite.Require().NoError(err)
+			rsp, err := suite.queryClient.EthCall(ctx, &types.EthCallRequest{
+				Args:       args,
+				GasCap:     uint64(config.DefaultGasCap),
+				PendingTxs: pendingTxs,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRecipient.Sign() == 0, rsp.Failed())
+
+			// the pending state is not persisted
+			suite.Require().Equal(big.NewInt(1_000_000), suite.app.EvmKeeper.GetBalance(suite.ctx, suite.address))
+			suite.Require().Zero(suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceTx() {
 	// TODO deploy contract that triggers internal transactions
 	var (
@@ -650,6 +990,29 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 			expPass:       true,
 			traceResponse: []byte{0x5b, 0x5d},
 		},
+		{
+			msg: "native 4byte tracer",
+			malleate: func() {
+				traceConfig = &types.TraceConfig{
+					Tracer: "4byteTracer",
+				}
+				predecessors = []*types.MsgEthereumTx{}
+			},
+			expPass:       true,
+			traceResponse: []byte(`{"0xa9059cbb-64":1}`),
+		},
+		{
+			msg: "native noop tracer with empty tracer config",
+			malleate: func() {
+				traceConfig = &types.TraceConfig{
+					Tracer:           "noopTracer",
+					TracerJsonConfig: `{}`,
+				}
+				predecessors = []*types.MsgEthereumTx{}
+			},
+			expPass:       true,
+			traceResponse: []byte(`{}`),
+		},
 		{
 			msg: "default trace with enableFeemarket",
 			malleate: func() {
@@ -738,10 +1101,66 @@ func (suite *KeeperTestSuite) TestTraceTx() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceTxNativeTracerConfig() {
+	testCases := []struct {
+		msg          string
+		tracer       string
+		tracerConfig string
+		expPass      bool
+		expCalls     bool
+	}{
+		{"call tracer", "callTracer", "", true, true},
+		{"call tracer with the inner calls", "callTracer", `{"onlyTopCall":false}`, true, true},
+		{"call tracer with only the top call", "callTracer", `{"onlyTopCall":true}`, true, false},
+		{"call tracer with invalid config", "callTracer", `[]`, false, false},
+		{"unsupported call tracer withLog option", "callTracer", `{"withLog":true}`, false, false},
+		{"unsupported prestate tracer diffMode option", "prestateTracer", `{"diffMode":true}`, false, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			// the message call contract calls another contract
+			contractAddr := suite.DeployTestMessageCall(suite.T())
+			suite.Commit()
+
+			input, err := types.TestMessageCall.ABI.Pack("benchmarkMessageCall", big.NewInt(2))
+			suite.Require().NoError(err)
+			chainID := suite.app.EvmKeeper.ChainID()
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			txMsg := types.NewTx(chainID, nonce, &contractAddr, big.NewInt(0), 1_000_000, big.NewInt(1), nil, nil, input, nil)
+			txMsg.From = suite.address.Hex()
+			suite.Require().NoError(txMsg.Sign(ethtypes.LatestSignerForChainID(chainID), suite.signer))
+
+			res, err := suite.queryClient.TraceTx(sdk.WrapSDKContext(suite.ctx), &types.QueryTraceTxRequest{
+				Msg:         txMsg,
+				TraceConfig: &types.TraceConfig{Tracer: tc.tracer, TracerJsonConfig: tc.tracerConfig},
+			})
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var frame map[string]interface{}
+			suite.Require().NoError(json.Unmarshal(res.Data, &frame))
+			suite.Require().Equal("CALL", frame["type"])
+			suite.Require().Equal(strings.ToLower(contractAddr.Hex()), frame["to"])
+
+			calls, found := frame["calls"]
+			suite.Require().Equal(tc.expCalls, found)
+			if tc.expCalls {
+				suite.Require().NotEmpty(calls)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestTraceBlock() {
 	var (
-		txs         []*types.MsgEthereumTx
-		traceConfig *types.TraceConfig
+		txs          []*types.MsgEthereumTx
+		predecessors []*types.MsgEthereumTx
+		traceConfig  *types.TraceConfig
 	)
 
 	testCases := []struct {
@@ -828,11 +1247,31 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 			traceResponse:   []byte{0x5b, 0x7b, 0x22, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3a, 0x7b, 0x22, 0x67, 0x61, 0x73, 0x22, 0x3a, 0x33, 0x34, 0x38, 0x32, 0x38, 0x2c, 0x22, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x3a, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x2c, 0x22, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3a, 0x22, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x31, 0x22, 0x2c, 0x22, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x3a, 0x5b, 0x7b, 0x22, 0x70, 0x63, 0x22, 0x3a, 0x30, 0x2c, 0x22, 0x6f, 0x70, 0x22, 0x3a, 0x22, 0x50, 0x55},
 			enableFeemarket: false,
 		},
+		{
+			msg: "chunk with predecessors",
+			malleate: func() {
+				traceConfig = &types.TraceConfig{
+					Tracer: "4byteTracer",
+				}
+
+				contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdk.NewIntWithDecimal(1000, 18).BigInt())
+				suite.Commit()
+				firstTx := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), sdk.NewIntWithDecimal(1, 18).BigInt())
+				secondTx := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec"), sdk.NewIntWithDecimal(1, 18).BigInt())
+				suite.Commit()
+				// trace the second tx on top of the state left by the first one
+				predecessors = []*types.MsgEthereumTx{firstTx}
+				txs = []*types.MsgEthereumTx{secondTx}
+			},
+			expPass:       true,
+			traceResponse: []byte(`[{"result":{"0xa9059cbb-64":1}}]`),
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			txs = []*types.MsgEthereumTx{}
+			predecessors = nil
 			suite.enableFeemarket = tc.enableFeemarket
 			suite.SetupTest()
 			// Deploy contract
@@ -846,8 +1285,9 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 
 			tc.malleate()
 			traceReq := types.QueryTraceBlockRequest{
-				Txs:         txs,
-				TraceConfig: traceConfig,
+				Txs:          txs,
+				Predecessors: predecessors,
+				TraceConfig:  traceConfig,
 			}
 			res, err := suite.queryClient.TraceBlock(sdk.WrapSDKContext(suite.ctx), &traceReq)
 
@@ -868,6 +1308,217 @@ func (suite *KeeperTestSuite) TestTraceBlock() {
 	suite.enableFeemarket = false // reset flag
 }
 
+func (suite *KeeperTestSuite) TestTraceCall() {
+	var (
+		args           types.TransactionArgs
+		traceConfig    *types.TraceConfig
+		stateOverrides []byte
+		blockOverrides []byte
+	)
+	sender := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+
+	testCases := []struct {
+		msg         string
+		malleate    func()
+		expPass     bool
+		expResponse string
+	}{
+		{
+			"negative output limit",
+			func() {
+				args = types.TransactionArgs{To: &common.Address{}, From: &sender}
+				traceConfig = &types.TraceConfig{Limit: -1}
+			},
+			false,
+			"",
+		},
+		{
+			"transfer without enough balance",
+			func() {
+				args = types.TransactionArgs{To: &common.Address{}, From: &sender, Value: (*hexutil.Big)(big.NewInt(100))}
+			},
+			true,
+			`{"gas":21000,"failed":true`,
+		},
+		{
+			"transfer with a balance state override",
+			func() {
+				args = types.TransactionArgs{To: &common.Address{}, From: &sender, Value: (*hexutil.Big)(big.NewInt(100))}
+				stateOverrides = []byte(fmt.Sprintf(`{"%s":{"balance":"0x64"}}`, sender.Hex()))
+			},
+			true,
+			`{"gas":21000,"failed":false`,
+		},
+		{
+			"erc20 transfer with the 4byte tracer",
+			func() {
+				contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdk.NewIntWithDecimal(1000, 18).BigInt())
+				suite.Commit()
+				transferData, err := types.ERC20Contract.ABI.Pack("transfer", sender, big.NewInt(1000))
+				suite.Require().NoError(err)
+				args = types.TransactionArgs{To: &contractAddr, From: &suite.address, Data: (*hexutil.Bytes)(&transferData)}
+				traceConfig = &types.TraceConfig{Tracer: "4byteTracer"}
+			},
+			true,
+			`{"0xa9059cbb-64":1}`,
+		},
+		{
+			"block number override",
+			func() {
+				args = types.TransactionArgs{To: &common.Address{}, From: &sender}
+				traceConfig = &types.TraceConfig{
+					Tracer: "{step: function() {}, fault: function() {}, result: function(ctx) { return ctx.block; }}",
+				}
+				blockOverrides = []byte(`{"number":"0x3e8"}`)
+			},
+			true,
+			`1000`,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			traceConfig = nil
+			stateOverrides = nil
+			blockOverrides = nil
+			tc.malleate()
+
+			bz, err := json.Marshal(&args)
+			suite.Require().NoError(err)
+			req := types.QueryTraceCallRequest{
+				Args:           bz,
+				GasCap:         25_000_000,
+				TraceConfig:    traceConfig,
+				StateOverrides: stateOverrides,
+				BlockOverrides: blockOverrides,
+			}
+
+			res, err := suite.queryClient.TraceCall(sdk.WrapSDKContext(suite.ctx), &req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().True(strings.HasPrefix(string(res.Data), tc.expResponse), string(res.Data))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestIntermediateRoots() {
+	var txs []*types.MsgEthereumTx
+	recipient := common.HexToAddress("0x378c50D9264C63F3F92B806d4ee56E9D86FfB3Ec")
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expRoots int
+	}{
+		{
+			"no txs",
+			func() {},
+			0,
+		},
+		{
+			"erc20 transfers",
+			func() {
+				contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdk.NewIntWithDecimal(1000, 18).BigInt())
+				suite.Commit()
+				firstTx := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdk.NewIntWithDecimal(1, 18).BigInt())
+				secondTx := suite.TransferERC20Token(suite.T(), contractAddr, suite.address, recipient, sdk.NewIntWithDecimal(1, 18).BigInt())
+				suite.Commit()
+				txs = []*types.MsgEthereumTx{firstTx, secondTx}
+			},
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest()
+			txs = nil
+			tc.malleate()
+
+			req := types.QueryIntermediateRootsRequest{Txs: txs}
+			res, err := suite.queryClient.IntermediateRoots(sdk.WrapSDKContext(suite.ctx), &req)
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Roots, tc.expRoots)
+			for i, root := range res.Roots {
+				suite.Require().NotEqual(common.Hash{}.Hex(), root)
+				if i > 0 {
+					suite.Require().NotEqual(res.Roots[i-1], root)
+				}
+			}
+
+			// the roots are deterministic and only depend on the previous txs
+			again, err := suite.queryClient.IntermediateRoots(sdk.WrapSDKContext(suite.ctx), &req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(res.Roots, again.Roots)
+			if len(txs) > 1 {
+				req.Txs = txs[:1]
+				first, err := suite.queryClient.IntermediateRoots(sdk.WrapSDKContext(suite.ctx), &req)
+				suite.Require().NoError(err)
+				suite.Require().Equal(res.Roots[:1], first.Roots)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestStorageRangeAt() {
+	suite.SetupTest()
+	contractAddr := suite.DeployTestContract(suite.T(), suite.address, sdk.NewIntWithDecimal(1000, 18).BigInt())
+	suite.Commit()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.queryClient.StorageRangeAt(ctx, &types.QueryStorageRangeAtRequest{Address: invalidAddress})
+	suite.Require().Error(err)
+
+	full, err := suite.queryClient.StorageRangeAt(ctx, &types.QueryStorageRangeAtRequest{
+		Address:   contractAddr.Hex(),
+		MaxResult: 100,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(full.Storage)
+	suite.Require().Empty(full.NextKey)
+	for i := 1; i < len(full.Storage); i++ {
+		prev := crypto.Keccak256Hash(common.HexToHash(full.Storage[i-1].Key).Bytes())
+		next := crypto.Keccak256Hash(common.HexToHash(full.Storage[i].Key).Bytes())
+		suite.Require().Equal(-1, bytes.Compare(prev.Bytes(), next.Bytes()))
+	}
+
+	// page through the storage one entry at a time
+	keyStart := ""
+	for i := range full.Storage {
+		res, err := suite.queryClient.StorageRangeAt(ctx, &types.QueryStorageRangeAtRequest{
+			Address:   contractAddr.Hex(),
+			KeyStart:  keyStart,
+			MaxResult: 1,
+		})
+		suite.Require().NoError(err)
+		suite.Require().Equal(full.Storage[i:i+1], res.Storage)
+		keyStart = res.NextKey
+	}
+	suite.Require().Empty(keyStart)
+
+	// the pages keep the smallest hashes after the key start
+	var paged []types.State
+	for {
+		res, err := suite.queryClient.StorageRangeAt(ctx, &types.QueryStorageRangeAtRequest{
+			Address:   contractAddr.Hex(),
+			KeyStart:  keyStart,
+			MaxResult: 2,
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(res.Storage), 2)
+		paged = append(paged, res.Storage...)
+		if res.NextKey == "" {
+			break
+		}
+		keyStart = res.NextKey
+	}
+	suite.Require().Equal(full.Storage, paged)
+}
+
 func (suite *KeeperTestSuite) TestNonceInQuery() {
 	suite.SetupTest()
 	priv, err := ethsecp256k1.GenerateKey()
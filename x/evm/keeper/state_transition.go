@@ -87,6 +87,7 @@ func (k *Keeper) NewEVM(
 		Difficulty:  big.NewInt(0), // unused. Only required in PoW context
 		BaseFee:     cfg.BaseFee,
 	}
+	cfg.BlockOverrides.Apply(&blockCtx)
 
 	txCtx := core.NewEVMTxContext(msg)
 	if tracer == nil {
@@ -329,29 +330,68 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 //
 // 1. set up the initial access list (iff fork > Berlin)
 //
+// State overrides
+//
+// If `cfg.Overrides` is set, the overrides are applied to the `StateDB` before the message is executed, this is
+// only allowed when commit is false.
+//
+// Shared StateDB
+//
+// If `cfg.StateDB` is set, the message is executed on it instead of a new `StateDB`, so the state changes of the
+// previous messages executed on it are visible. The state overrides are not applied in this case, they are
+// expected to be applied to the shared `StateDB` once by the caller.
+//
 // Tracer parameter
 //
 // It should be a `vm.Tracer` object or nil, if pass `nil`, it'll create a default one based on keeper options.
 //
 // Commit parameter
 //
-// If commit is true, the `StateDB` will be committed, otherwise discarded.
+// If commit is true, the `StateDB` will be committed, otherwise discarded, or kept by the caller if shared.
 func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool, cfg *types.EVMConfig, txConfig statedb.TxConfig) (*types.MsgEthereumTxResponse, error) {
+	res, _, err := k.applyMessageWithConfig(ctx, msg, tracer, commit, cfg, txConfig)
+	return res, err
+}
+
+// applyMessageWithConfig implements ApplyMessageWithConfig, it also returns the gas refunded at the end of the
+// execution, the gas needed by the execution is the gas used plus the refund.
+func (k *Keeper) applyMessageWithConfig(ctx sdk.Context, msg core.Message, tracer vm.EVMLogger, commit bool, cfg *types.EVMConfig, txConfig statedb.TxConfig) (*types.MsgEthereumTxResponse, uint64, error) {
 	var (
-		ret   []byte // return bytes from evm execution
-		vmErr error  // vm errors do not effect consensus and are therefore not assigned to err
+		ret         []byte // return bytes from evm execution
+		vmErr       error  // vm errors do not effect consensus and are therefore not assigned to err
+		leftoverGas uint64 // gas left after the execution, refund included
 	)
 
 	// return error if contract creation or call are disabled through governance
 	if !cfg.Params.EnableCreate && msg.To() == nil {
-		return nil, sdkerrors.Wrap(types.ErrCreateDisabled, "failed to create new contract")
+		return nil, 0, sdkerrors.Wrap(types.ErrCreateDisabled, "failed to create new contract")
 	} else if !cfg.Params.EnableCall && msg.To() != nil {
-		return nil, sdkerrors.Wrap(types.ErrCallDisabled, "failed to call contract")
+		return nil, 0, sdkerrors.Wrap(types.ErrCallDisabled, "failed to call contract")
+	}
+
+	if (cfg.Overrides != nil || cfg.BlockOverrides != nil) && commit {
+		return nil, 0, sdkerrors.Wrap(types.ErrInvalidStateOverride, "state and block overrides can't be committed")
 	}
 
-	stateDB := statedb.New(ctx, k, txConfig)
+	stateDB := cfg.StateDB
+	if stateDB != nil {
+		stateDB.Prepare(txConfig)
+	} else {
+		stateDB = statedb.New(ctx, k, txConfig)
+		if err := cfg.Overrides.Apply(stateDB); err != nil {
+			return nil, 0, sdkerrors.Wrap(types.ErrInvalidStateOverride, err.Error())
+		}
+	}
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
+	// the tracers get the gas limit and the gas left after the refund, like in the geth state transition
+	if evm.Config.Debug {
+		evm.Config.Tracer.CaptureTxStart(msg.Gas())
+		defer func() {
+			evm.Config.Tracer.CaptureTxEnd(leftoverGas)
+		}()
+	}
+
 	sender := vm.AccountRef(msg.From())
 	contractCreation := msg.To() == nil
 	isLondon := cfg.ChainConfig.IsLondon(evm.Context.BlockNumber)
@@ -359,14 +399,14 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 	intrinsicGas, err := k.GetEthIntrinsicGas(ctx, msg, cfg.ChainConfig, contractCreation)
 	if err != nil {
 		// should have already been checked on Ante Handler
-		return nil, sdkerrors.Wrap(err, "intrinsic gas failed")
+		return nil, 0, sdkerrors.Wrap(err, "intrinsic gas failed")
 	}
 	// Should check again even if it is checked on Ante Handler, because eth_call don't go through Ante Handler.
 	if msg.Gas() < intrinsicGas {
 		// eth_estimateGas will check for this exact error
-		return nil, sdkerrors.Wrap(core.ErrIntrinsicGas, "apply message")
+		return nil, 0, sdkerrors.Wrap(core.ErrIntrinsicGas, "apply message")
 	}
-	leftoverGas := msg.Gas() - intrinsicGas
+	leftoverGas = msg.Gas() - intrinsicGas
 
 	// access list preparation is moved from ante handler to here, because it's needed when `ApplyMessage` is called
 	// under contexts where ante handlers are not run, for example `eth_call` and `eth_estimateGas`.
@@ -394,14 +434,15 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 
 	// calculate gas refund
 	if msg.Gas() < leftoverGas {
-		return nil, sdkerrors.Wrap(types.ErrGasOverflow, "apply message")
+		return nil, 0, sdkerrors.Wrap(types.ErrGasOverflow, "apply message")
 	}
 	gasUsed := msg.Gas() - leftoverGas
 	refund := GasToRefund(stateDB.GetRefund(), gasUsed, refundQuotient)
 	if refund > gasUsed {
-		return nil, sdkerrors.Wrap(types.ErrGasOverflow, "apply message")
+		return nil, 0, sdkerrors.Wrap(types.ErrGasOverflow, "apply message")
 	}
 	gasUsed -= refund
+	leftoverGas += refund
 
 	// EVM execution error needs to be available for the JSON-RPC client
 	var vmError string
@@ -412,7 +453,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 	// The dirty states in `StateDB` is either committed or discarded after return
 	if commit {
 		if err := stateDB.Commit(); err != nil {
-			return nil, sdkerrors.Wrap(err, "failed to commit stateDB")
+			return nil, 0, sdkerrors.Wrap(err, "failed to commit stateDB")
 		}
 	}
 
@@ -422,7 +463,7 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context, msg core.Message, trace
 		Ret:     ret,
 		Logs:    types.NewLogsFromEth(stateDB.Logs()),
 		Hash:    txConfig.TxHash.Hex(),
-	}, nil
+	}, refund, nil
 }
 
 // ApplyMessage calls ApplyMessageWithConfig with default EVMConfig
@@ -241,6 +241,9 @@ func EstimateGas(ctx *simulateContext, from, to *common.Address, data *hexutil.B
 	if err != nil {
 		return 0, err
 	}
+	if res.Failed() {
+		return 0, types.NewExecErrorWithReason(res.Revert())
+	}
 	return res.Gas, nil
 }
 
@@ -86,6 +86,18 @@ func (s *StateDB) Logs() []*ethtypes.Log {
 	return s.logs
 }
 
+// Prepare sets the current transaction before executing it on a StateDB
+// shared with the previous transactions. The state changes are kept while
+// the per-transaction logs, refund counter and access list are reset.
+// NOTE: the committed state is still the one before the first transaction.
+func (s *StateDB) Prepare(txConfig TxConfig) {
+	s.txConfig = txConfig
+	s.logs = nil
+	s.refund = 0
+	s.accessList = newAccessList()
+	s.validRevisions = s.validRevisions[:0]
+}
+
 // AddRefund adds gas to the refund counter
 func (s *StateDB) AddRefund(gas uint64) {
 	s.journal.append(refundChange{prev: s.refund})
@@ -267,6 +279,15 @@ func (s *StateDB) ForEachStorage(addr common.Address, cb func(key, value common.
 	if so == nil {
 		return nil
 	}
+	if so.fakeStorage != nil {
+		for _, key := range so.fakeStorage.SortedKeys() {
+			value := so.GetState(key)
+			if !cb(key, value) {
+				return nil
+			}
+		}
+		return nil
+	}
 	s.keeper.ForEachStorage(s.ctx, addr, func(key, value common.Hash) bool {
 		if value, dirty := so.dirtyStorage[key]; dirty {
 			return cb(key, value)
@@ -303,6 +324,14 @@ func (s *StateDB) SubBalance(addr common.Address, amount *big.Int) {
 	}
 }
 
+// SetBalance sets the balance of account.
+func (s *StateDB) SetBalance(addr common.Address, amount *big.Int) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetBalance(amount)
+	}
+}
+
 // SetNonce sets the nonce of account.
 func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 	stateObject := s.getOrNewStateObject(addr)
@@ -327,6 +356,16 @@ func (s *StateDB) SetState(addr common.Address, key, value common.Hash) {
 	}
 }
 
+// SetStorage replaces the entire storage for the specified account with given
+// storage. This function should only be used for debugging and the mutations
+// must be discarded afterwards.
+func (s *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := s.getOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetStorage(storage)
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -461,3 +500,38 @@ func (s *StateDB) Commit() error {
 	}
 	return nil
 }
+
+// DirtyHash returns the keccak256 hash of the dirty states, as they are written by Commit: for
+// each dirty account in the address order, the address, a byte set to 1 if it's deleted and,
+// if not, the nonce, the balance and the code hash, followed by the number of changed storage
+// slots and their keys and values in the key order.
+func (s *StateDB) DirtyHash() common.Hash {
+	var bz []byte
+	for _, addr := range s.journal.sortedDirties() {
+		obj := s.stateObjects[addr]
+		bz = append(bz, addr.Bytes()...)
+		if obj.suicided {
+			bz = append(bz, 1)
+			continue
+		}
+		bz = append(bz, 0)
+		bz = append(bz, sdk.Uint64ToBigEndian(obj.account.Nonce)...)
+		bz = append(bz, common.BigToHash(obj.account.Balance).Bytes()...)
+		bz = append(bz, obj.account.CodeHash...)
+
+		var slots []byte
+		count := uint64(0)
+		for _, key := range obj.dirtyStorage.SortedKeys() {
+			value := obj.dirtyStorage[key]
+			if value == obj.originStorage[key] {
+				continue
+			}
+			slots = append(slots, key.Bytes()...)
+			slots = append(slots, value.Bytes()...)
+			count++
+		}
+		bz = append(bz, sdk.Uint64ToBigEndian(count)...)
+		bz = append(bz, slots...)
+	}
+	return crypto.Keccak256Hash(bz)
+}
@@ -244,6 +244,72 @@ func (suite *StateDBTestSuite) TestState() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestSetStorage() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	key2 := common.BigToHash(big.NewInt(3))
+	value2 := common.BigToHash(big.NewInt(4))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetStorage(address, map[common.Hash]common.Hash{key2: value2})
+
+	// the committed state is replaced by the fake storage
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key1))
+	suite.Require().Equal(common.Hash{}, db.GetCommittedState(address, key1))
+	suite.Require().Equal(value2, db.GetState(address, key2))
+	suite.Require().Equal(value2, db.GetCommittedState(address, key2))
+	suite.Require().Equal(statedb.Storage{key2: value2}, CollectContractStorage(db))
+
+	// dirty state still takes precedence
+	db.SetState(address, key2, value1)
+	suite.Require().Equal(value1, db.GetState(address, key2))
+	suite.Require().Equal(value2, db.GetCommittedState(address, key2))
+
+	// keeper is untouched
+	suite.Require().Equal(statedb.Storage{key1: value1}, keeper.accounts[address].states)
+}
+
+func (suite *StateDBTestSuite) TestDirtyHash() {
+	key1 := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(2))
+	value2 := common.BigToHash(big.NewInt(3))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.SetState(address, key1, value1)
+	suite.Require().NoError(db.Commit())
+
+	// writing back the committed value is a noop storage change, only the account is hashed
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	noopHash := db.DirtyHash()
+	db.SetState(address, key1, value2)
+	db.SetState(address, key1, value1)
+	suite.Require().NotEqual(noopHash, db.DirtyHash())
+	db2 := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db2.SetNonce(address, 0)
+	suite.Require().Equal(db.DirtyHash(), db2.DirtyHash())
+
+	// the same changes give the same hash, in any order
+	db = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db.AddBalance(address, big.NewInt(10))
+	db.SetState(address2, key1, value1)
+	db2 = statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	db2.SetState(address2, key1, value1)
+	db2.AddBalance(address, big.NewInt(10))
+	suite.Require().Equal(db.DirtyHash(), db2.DirtyHash())
+
+	db2.AddBalance(address, big.NewInt(1))
+	suite.Require().NotEqual(db.DirtyHash(), db2.DirtyHash())
+
+	// computing the hash doesn't commit the changes
+	suite.Require().Equal(big.NewInt(0), statedb.New(sdk.Context{}, keeper, emptyTxConfig).GetBalance(address))
+}
+
 func (suite *StateDBTestSuite) TestCode() {
 	code := []byte("hello world")
 	codeHash := crypto.Keccak256Hash(code)
@@ -503,6 +569,39 @@ func (suite *StateDBTestSuite) TestLog() {
 	suite.Require().Equal(expecedLog, db.Logs()[1])
 }
 
+func (suite *StateDBTestSuite) TestPrepare() {
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	key := common.BigToHash(big.NewInt(1))
+	value := common.BigToHash(big.NewInt(2))
+
+	// first tx
+	db.SetState(address, key, value)
+	db.AddBalance(address, big.NewInt(100))
+	db.AddRefund(10)
+	db.AddSlotToAccessList(address, key)
+	db.AddLog(&ethtypes.Log{Address: address})
+
+	// the per-transaction states are reset for the next tx
+	txHash := common.BytesToHash([]byte("tx"))
+	db.Prepare(statedb.NewTxConfig(blockHash, txHash, 1, 0))
+	suite.Require().Empty(db.Logs())
+	suite.Require().Zero(db.GetRefund())
+	suite.Require().False(db.AddressInAccessList(address))
+
+	// while the state changes are kept
+	suite.Require().Equal(value, db.GetState(address, key))
+	suite.Require().Equal(big.NewInt(100), db.GetBalance(address))
+
+	db.AddLog(&ethtypes.Log{Address: address})
+	suite.Require().Equal(txHash, db.Logs()[0].TxHash)
+	suite.Require().Equal(uint(1), db.Logs()[0].TxIndex)
+
+	// and can still be committed
+	suite.Require().NoError(db.Commit())
+	suite.Require().Equal(value, keeper.GetState(sdk.Context{}, address, key))
+}
+
 func (suite *StateDBTestSuite) TestRefund() {
 	testCases := []struct {
 		name      string
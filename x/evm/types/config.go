@@ -5,6 +5,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/tharsis/ethermint/x/evm/statedb"
 )
 
 // EVMConfig encapulates common parameters needed to create an EVM to execute a message
@@ -14,4 +16,13 @@ type EVMConfig struct {
 	ChainConfig *params.ChainConfig
 	CoinBase    common.Address
 	BaseFee     *big.Int
+	// Overrides are the state overrides applied to the StateDB before the
+	// message is executed, only used by non committing queries like eth_call.
+	Overrides *StateOverride
+	// BlockOverrides are the header fields overridden in the block context of
+	// the EVM, only used by non committing queries like debug_traceCall.
+	BlockOverrides *BlockOverrides
+	// StateDB is the StateDB the message is executed on instead of a fresh
+	// one, so consecutive messages see the state changes of the previous ones.
+	StateDB *statedb.StateDB
 }
@@ -1,8 +1,10 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"math/big"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
@@ -10,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 const (
@@ -33,6 +36,7 @@ const (
 	codeErrInvalidBaseFee
 	codeErrGasOverflow
 	codeErrInvalidAccount
+	codeErrInvalidStateOverride
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -97,13 +101,66 @@ var (
 
 	// ErrInvalidAccount returns an error if the account is not an EVM compatible account
 	ErrInvalidAccount = sdkerrors.Register(ModuleName, codeErrInvalidAccount, "account type is not a valid ethereum account")
+
+	// ErrInvalidStateOverride returns an error if the state overrides of a call are invalid
+	ErrInvalidStateOverride = sdkerrors.Register(ModuleName, codeErrInvalidStateOverride, "invalid state override")
+)
+
+var (
+	// revertSelector is the selector of the solidity `Error(string)` revert
+	revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	// panicSelector is the selector of the solidity `Panic(uint256)` revert
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	// panicReasons are the descriptions of the solidity panic codes.
+	// See: https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+	panicReasons = map[uint64]string{
+		0x00: "generic panic",
+		0x01: "assert(false)",
+		0x11: "arithmetic underflow or overflow",
+		0x12: "division or modulo by zero",
+		0x21: "enum overflow",
+		0x22: "invalid encoded storage byte array accessed",
+		0x31: "out-of-bounds array access; popping on an empty array",
+		0x32: "out-of-bounds access of an array or bytesN",
+		0x41: "out of memory",
+		0x51: "uninitialized function",
+	}
 )
 
+// UnpackRevertReason decodes the `Error(string)` and `Panic(uint256)` reverts
+// emitted by solidity, the custom errors are left to the caller as they require
+// the contract ABI.
+func UnpackRevertReason(data []byte) (string, error) {
+	if len(data) < 4 {
+		return "", errors.New("invalid data for unpacking")
+	}
+
+	switch {
+	case bytes.Equal(data[:4], revertSelector):
+		return abi.UnpackRevert(data)
+	case bytes.Equal(data[:4], panicSelector):
+		if len(data) != 4+32 {
+			return "", errors.New("invalid data for unpacking")
+		}
+		code := new(big.Int).SetBytes(data[4:])
+		if !code.IsUint64() {
+			return fmt.Sprintf("unknown panic code: %#x", code), nil
+		}
+		if reason, ok := panicReasons[code.Uint64()]; ok {
+			return reason, nil
+		}
+		return fmt.Sprintf("unknown panic code: %#x", code.Uint64()), nil
+	default:
+		return "", errors.New("invalid data for unpacking")
+	}
+}
+
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
 // with the return reason.
 func NewExecErrorWithReason(revertReason []byte) *RevertError {
 	result := common.CopyBytes(revertReason)
-	reason, errUnpack := abi.UnpackRevert(result)
+	reason, errUnpack := UnpackRevertReason(result)
 	err := errors.New("execution reverted")
 	if errUnpack == nil {
 		err = fmt.Errorf("execution reverted: %v", reason)
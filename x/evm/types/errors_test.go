@@ -1,15 +1,14 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
-	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/status-im/keycard-go/hexutils"
 	"github.com/stretchr/testify/require"
 )
 
-var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
-
 func TestNewExecErrorWithReason(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -41,6 +40,24 @@ func TestNewExecErrorWithReason(t *testing.T) {
 			hexutils.HexToBytes("08C379A00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000F434F554E5445525F544F4F5F4C4F570000000000000000000000000000000000"),
 			"0x08c379a00000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000f434f554e5445525f544f4f5f4c4f570000000000000000000000000000000000",
 		},
+		{
+			"With panic code",
+			"execution reverted: arithmetic underflow or overflow",
+			append(common.CopyBytes(panicSelector), common.BigToHash(big.NewInt(0x11)).Bytes()...),
+			"0x4e487b710000000000000000000000000000000000000000000000000000000000000011",
+		},
+		{
+			"With unknown panic code",
+			"execution reverted: unknown panic code: 0x99",
+			append(common.CopyBytes(panicSelector), common.BigToHash(big.NewInt(0x99)).Bytes()...),
+			"0x4e487b710000000000000000000000000000000000000000000000000000000000000099",
+		},
+		{
+			"With truncated panic code",
+			"execution reverted",
+			append(common.CopyBytes(panicSelector), 0x11),
+			"0x4e487b7111",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -547,6 +547,9 @@ type TraceConfig struct {
 	EnableMemory bool `protobuf:"varint,11,opt,name=enable_memory,json=enableMemory,proto3" json:"enableMemory"`
 	// enable return data capture
 	EnableReturnData bool `protobuf:"varint,12,opt,name=enable_return_data,json=enableReturnData,proto3" json:"enableReturnData"`
+	// JSON encoded config of the native or JavaScript tracer, e.g.
+	// {"onlyTopCall": true} for the callTracer
+	TracerJsonConfig string `protobuf:"bytes,13,opt,name=tracer_json_config,json=tracerJsonConfig,proto3" json:"tracerJsonConfig"`
 }
 
 func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
@@ -652,6 +655,13 @@ func (m *TraceConfig) GetEnableReturnData() bool {
 	return false
 }
 
+func (m *TraceConfig) GetTracerJsonConfig() string {
+	if m != nil {
+		return m.TracerJsonConfig
+	}
+	return ""
+}
+
 // EIP712AllowedMsg stores an allowed legacy msg and its eip712 type.
 type EIP712AllowedMsg struct {
 	// msg's proto type name. ie "/cosmos.bank.v1beta1.MsgSend"
@@ -850,113 +860,114 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1683 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x4e, 0xe4, 0xc8,
-	0x15, 0x06, 0xda, 0x80, 0xbb, 0xda, 0x74, 0x9b, 0x6a, 0x76, 0xd2, 0x33, 0xa3, 0x60, 0xe2, 0x28,
-	0x11, 0x91, 0x76, 0x60, 0x61, 0x85, 0x66, 0xb4, 0xa3, 0x28, 0xc2, 0x0c, 0xbb, 0x0b, 0x99, 0x99,
-	0xa0, 0x82, 0x4d, 0xa4, 0x48, 0x91, 0x55, 0x6d, 0xd7, 0x1a, 0x2f, 0xb6, 0xab, 0x55, 0x55, 0xdd,
-	0xd3, 0x1d, 0xe5, 0x01, 0x22, 0xe5, 0x26, 0x8f, 0x90, 0x57, 0xc8, 0x3b, 0xe4, 0x62, 0x95, 0xab,
-	0xbd, 0x8c, 0x72, 0x61, 0xad, 0x98, 0x3b, 0x2e, 0x79, 0x81, 0x44, 0xf5, 0xd3, 0xbf, 0x30, 0xc9,
-	0x36, 0x57, 0x5d, 0xe7, 0xef, 0xfb, 0xea, 0x9c, 0x3a, 0xae, 0x63, 0x37, 0x78, 0x42, 0xc4, 0x25,
-	0x61, 0x79, 0x5a, 0x88, 0x5d, 0xd2, 0xcb, 0x77, 0x7b, 0x7b, 0xf2, 0x67, 0xa7, 0xc3, 0xa8, 0xa0,
-	0xd0, 0x1d, 0xd9, 0x76, 0xa4, 0xb2, 0xb7, 0xf7, 0x64, 0x23, 0xa1, 0x09, 0x55, 0xc6, 0x5d, 0xb9,
-	0xd2, 0x7e, 0xfe, 0x3f, 0x2a, 0x60, 0xe5, 0x0c, 0x33, 0x9c, 0x73, 0xb8, 0x07, 0xaa, 0xa4, 0x97,
-	0x87, 0x31, 0x29, 0x68, 0xde, 0x5a, 0xdc, 0x5a, 0xdc, 0xae, 0x06, 0x1b, 0xb7, 0xa5, 0xe7, 0x0e,
-	0x70, 0x9e, 0x7d, 0xe6, 0x8f, 0x4c, 0x3e, 0xb2, 0x49, 0x2f, 0x7f, 0x25, 0x97, 0xf0, 0x97, 0x60,
-	0x8d, 0x14, 0xb8, 0x9d, 0x91, 0x30, 0x62, 0x04, 0x0b, 0xd2, 0x5a, 0xda, 0x5a, 0xdc, 0xb6, 0x83,
-	0xd6, 0x6d, 0xe9, 0x6d, 0x98, 0xb0, 0x49, 0xb3, 0x8f, 0x1c, 0x2d, 0x1f, 0x29, 0x11, 0x3e, 0x07,
-	0xb5, 0xa1, 0x1d, 0x67, 0x59, 0xab, 0xa2, 0x82, 0x1f, 0xdd, 0x96, 0x1e, 0x9c, 0x0e, 0xc6, 0x59,
-	0xe6, 0x23, 0x60, 0x42, 0x71, 0x96, 0xc1, 0x43, 0x00, 0x48, 0x5f, 0x30, 0x1c, 0x92, 0xb4, 0xc3,
-	0x5b, 0xd6, 0x56, 0x65, 0xbb, 0x12, 0xf8, 0xd7, 0xa5, 0x57, 0x3d, 0x96, 0xda, 0xe3, 0x93, 0x33,
-	0x7e, 0x5b, 0x7a, 0xeb, 0x06, 0x64, 0xe4, 0xe8, 0xa3, 0xaa, 0x12, 0x8e, 0xd3, 0x0e, 0x87, 0x7f,
-	0x00, 0x4e, 0x74, 0x89, 0xd3, 0x22, 0x8c, 0x68, 0xf1, 0x75, 0x9a, 0xb4, 0x96, 0xb7, 0x16, 0xb7,
-	0x6b, 0xfb, 0x3f, 0xde, 0x99, 0xad, 0xdb, 0xce, 0x91, 0xf4, 0x3a, 0x52, 0x4e, 0xc1, 0xd3, 0x6f,
-	0x4b, 0x6f, 0xe1, 0xb6, 0xf4, 0x9a, 0x1a, 0x7a, 0x12, 0xc0, 0x47, 0xb5, 0x68, 0xec, 0x09, 0x73,
-	0xd0, 0x24, 0x69, 0xe7, 0xf9, 0xde, 0x7e, 0x88, 0xb3, 0x8c, 0xbe, 0x23, 0x71, 0x98, 0xf3, 0x84,
-	0xb7, 0x56, 0xb6, 0x2a, 0xdb, 0xb5, 0x7d, 0xff, 0x2e, 0xcb, 0xf1, 0xc9, 0xd9, 0xf3, 0xbd, 0xfd,
-	0x43, 0xed, 0xfb, 0x86, 0x27, 0xc1, 0x63, 0x49, 0x75, 0x5d, 0x7a, 0xeb, 0xb3, 0x16, 0x8e, 0xd6,
-	0x35, 0xf2, 0x84, 0xca, 0xff, 0x7b, 0x1d, 0xd4, 0x8e, 0xa6, 0xe8, 0x1b, 0x97, 0x34, 0x27, 0x5c,
-	0x10, 0x1c, 0x87, 0xed, 0x8c, 0x46, 0x57, 0xe6, 0x44, 0x5f, 0xfd, 0xbb, 0xf4, 0x7e, 0x9e, 0xa4,
-	0xe2, 0xb2, 0xdb, 0xde, 0x89, 0x68, 0xbe, 0x1b, 0x51, 0x9e, 0x53, 0x6e, 0x7e, 0x9e, 0xf1, 0xf8,
-	0x6a, 0x57, 0x0c, 0x3a, 0x84, 0xef, 0x9c, 0x14, 0xe2, 0xb6, 0xf4, 0x1e, 0xe9, 0x3c, 0x67, 0xa0,
-	0x7c, 0x54, 0x1f, 0x69, 0x02, 0xa9, 0x80, 0x03, 0x50, 0x8f, 0x31, 0x0d, 0xbf, 0xa6, 0xec, 0xca,
-	0xb0, 0x2d, 0x29, 0xb6, 0xf3, 0x1f, 0xce, 0x76, 0x5d, 0x7a, 0xce, 0xab, 0xc3, 0xdf, 0x7c, 0x4e,
-	0xd9, 0x95, 0xc2, 0xbc, 0x2d, 0xbd, 0x8f, 0x34, 0xfb, 0x34, 0xb2, 0x8f, 0x9c, 0x18, 0xd3, 0x91,
-	0x1b, 0xfc, 0x1d, 0x70, 0x47, 0x0e, 0xbc, 0xdb, 0xe9, 0x50, 0x26, 0x4c, 0x23, 0x3d, 0xbb, 0x2e,
-	0xbd, 0xba, 0x81, 0x3c, 0xd7, 0x96, 0xdb, 0xd2, 0xfb, 0xd1, 0x0c, 0xa8, 0x89, 0xf1, 0x51, 0xdd,
-	0xc0, 0x1a, 0x57, 0xc8, 0x81, 0x43, 0xd2, 0xce, 0xde, 0xc1, 0x27, 0x26, 0x23, 0x4b, 0x65, 0x74,
-	0x36, 0x57, 0x46, 0xb5, 0xe3, 0x93, 0xb3, 0xbd, 0x83, 0x4f, 0x86, 0x09, 0x99, 0xb6, 0x99, 0x84,
-	0xf5, 0x51, 0x4d, 0x8b, 0x3a, 0x9b, 0x13, 0x60, 0xc4, 0xf0, 0x12, 0xf3, 0x4b, 0xd5, 0x94, 0xd5,
-	0x60, 0xfb, 0xba, 0xf4, 0x80, 0x46, 0xfa, 0x12, 0xf3, 0xcb, 0xf1, 0xb9, 0xb4, 0x07, 0x7f, 0xc4,
-	0x85, 0x48, 0xbb, 0xf9, 0x10, 0x0b, 0xe8, 0x60, 0xe9, 0x35, 0xda, 0xff, 0x81, 0xd9, 0xff, 0xca,
-	0x83, 0xf7, 0x7f, 0x70, 0xdf, 0xfe, 0x0f, 0xa6, 0xf7, 0xaf, 0x7d, 0x46, 0xa4, 0x2f, 0x0c, 0xe9,
-	0xea, 0x83, 0x49, 0x5f, 0xdc, 0x47, 0xfa, 0x62, 0x9a, 0x54, 0xfb, 0xc8, 0x66, 0x9f, 0xa9, 0x44,
-	0xcb, 0x7e, 0x78, 0xb3, 0xdf, 0x29, 0x6a, 0x7d, 0xa4, 0xd1, 0x74, 0x7f, 0x02, 0x1b, 0x11, 0x2d,
-	0xb8, 0x90, 0xba, 0x82, 0x76, 0x32, 0x62, 0x38, 0xab, 0x8a, 0xf3, 0x64, 0x2e, 0xce, 0xa7, 0xe6,
-	0x22, 0xb9, 0x07, 0xcf, 0x47, 0xcd, 0x69, 0xb5, 0x66, 0xef, 0x00, 0xb7, 0x43, 0x04, 0x61, 0xbc,
-	0xdd, 0x65, 0x89, 0x61, 0x06, 0x8a, 0xf9, 0x78, 0x2e, 0x66, 0xf3, 0x1c, 0xcc, 0x62, 0xf9, 0xa8,
-	0x31, 0x56, 0x69, 0xc6, 0x6f, 0x40, 0x3d, 0x95, 0xdb, 0x68, 0x77, 0x33, 0xc3, 0x57, 0x53, 0x7c,
-	0x47, 0x73, 0xf1, 0x99, 0x87, 0x79, 0x1a, 0xc9, 0x47, 0x6b, 0x43, 0x85, 0xe6, 0xea, 0x02, 0x98,
-	0x77, 0x53, 0x16, 0x26, 0x19, 0x8e, 0x52, 0xc2, 0x0c, 0x9f, 0xa3, 0xf8, 0xbe, 0x98, 0x8b, 0xef,
-	0xb1, 0xe6, 0xbb, 0x8b, 0xe6, 0x23, 0x57, 0x2a, 0xbf, 0xd0, 0x3a, 0x4d, 0x1b, 0x03, 0xa7, 0x4d,
-	0x58, 0x96, 0x16, 0x86, 0x70, 0x4d, 0x11, 0x1e, 0xce, 0x45, 0x68, 0xfa, 0x74, 0x12, 0xc7, 0x47,
-	0x35, 0x2d, 0x8e, 0x58, 0x32, 0x5a, 0xc4, 0x74, 0xc8, 0xb2, 0xfe, 0x70, 0x96, 0x49, 0x1c, 0x1f,
-	0xd5, 0xb4, 0xa8, 0x59, 0xfa, 0xa0, 0x89, 0x19, 0xa3, 0xef, 0x66, 0x6a, 0x08, 0x15, 0xd9, 0x97,
-	0x73, 0x91, 0x3d, 0xd1, 0x64, 0xf7, 0xc0, 0xf9, 0x68, 0x5d, 0x69, 0xa7, 0xaa, 0x48, 0x81, 0x9b,
-	0x13, 0x96, 0x90, 0xc9, 0x39, 0xd0, 0x7c, 0x78, 0x6b, 0xce, 0x62, 0xf9, 0xa8, 0xae, 0x54, 0xa3,
-	0xbb, 0xff, 0xd4, 0xb2, 0xeb, 0x6e, 0xe3, 0xd4, 0xb2, 0x1b, 0xae, 0x7b, 0x6a, 0xd9, 0xae, 0xbb,
-	0x8e, 0xd6, 0x06, 0x34, 0xa3, 0x61, 0xef, 0x53, 0x1d, 0x81, 0x6a, 0xe4, 0x1d, 0xe6, 0xe6, 0x41,
-	0x46, 0xf5, 0x08, 0x0b, 0x9c, 0x0d, 0xb8, 0x30, 0x70, 0xbb, 0x60, 0xf9, 0x5c, 0xc8, 0xd7, 0x10,
-	0x17, 0x54, 0xae, 0xc8, 0x40, 0x0f, 0x48, 0x24, 0x97, 0x70, 0x03, 0x2c, 0xf7, 0x70, 0xd6, 0xd5,
-	0xef, 0x33, 0x55, 0xa4, 0x05, 0xff, 0x0c, 0x34, 0x2e, 0x18, 0x2e, 0x38, 0x8e, 0x44, 0x4a, 0x8b,
-	0xd7, 0x34, 0xe1, 0x10, 0x02, 0x4b, 0x5d, 0xd4, 0x3a, 0x56, 0xad, 0xe1, 0x2f, 0x80, 0x95, 0xd1,
-	0x84, 0xb7, 0x96, 0xd4, 0xac, 0xff, 0xe8, 0xee, 0xac, 0x7f, 0x4d, 0x13, 0xa4, 0x5c, 0xfc, 0x7f,
-	0x2e, 0x81, 0xca, 0x6b, 0x9a, 0xc0, 0x16, 0x58, 0xc5, 0x71, 0xcc, 0x08, 0xe7, 0x06, 0x69, 0x28,
-	0xc2, 0x47, 0x60, 0x45, 0xd0, 0x4e, 0x1a, 0x69, 0xb8, 0x2a, 0x32, 0x92, 0x24, 0x8e, 0xb1, 0xc0,
-	0x6a, 0xd4, 0x39, 0x48, 0xad, 0xe1, 0x3e, 0x70, 0x54, 0x66, 0x61, 0xd1, 0xcd, 0xdb, 0x84, 0xa9,
-	0x89, 0x65, 0x05, 0x8d, 0x9b, 0xd2, 0xab, 0x29, 0xfd, 0x5b, 0xa5, 0x46, 0x93, 0x02, 0xfc, 0x18,
-	0xac, 0x8a, 0xfe, 0xe4, 0xb0, 0x69, 0xde, 0x94, 0x5e, 0x43, 0x8c, 0xd3, 0x94, 0xb3, 0x04, 0xad,
-	0x88, 0xbe, 0x9a, 0x29, 0xbb, 0xc0, 0x16, 0xfd, 0x30, 0x2d, 0x62, 0xd2, 0x57, 0xf3, 0xc4, 0x0a,
-	0x36, 0x6e, 0x4a, 0xcf, 0x9d, 0x70, 0x3f, 0x91, 0x36, 0xb4, 0x2a, 0xfa, 0x6a, 0x01, 0x3f, 0x06,
-	0x40, 0x6f, 0x49, 0x31, 0xe8, 0x69, 0xb0, 0x76, 0x53, 0x7a, 0x55, 0xa5, 0x55, 0xd8, 0xe3, 0x25,
-	0xf4, 0xc1, 0xb2, 0xc6, 0xb6, 0x15, 0xb6, 0x73, 0x53, 0x7a, 0x76, 0x46, 0x13, 0x8d, 0xa9, 0x4d,
-	0xb2, 0x54, 0x8c, 0xe4, 0xb4, 0x47, 0x62, 0x75, 0xe1, 0xda, 0x68, 0x28, 0xfa, 0x7f, 0x59, 0x02,
-	0xf6, 0x45, 0x1f, 0x11, 0xde, 0xcd, 0x04, 0xfc, 0x1c, 0xb8, 0x11, 0x2d, 0x04, 0xc3, 0x91, 0x08,
-	0xa7, 0x4a, 0x1b, 0x3c, 0x1d, 0x77, 0xd8, 0xac, 0x87, 0x8f, 0x1a, 0x43, 0xd5, 0xa1, 0xa9, 0xff,
-	0x06, 0x58, 0x6e, 0x67, 0x94, 0xe6, 0xaa, 0x13, 0x1c, 0xa4, 0x05, 0x88, 0x54, 0xd5, 0xd4, 0x29,
-	0x57, 0xd4, 0x7b, 0xe3, 0x4f, 0xee, 0x9e, 0xf2, 0x4c, 0xab, 0x04, 0x8f, 0xcc, 0xbb, 0x63, 0x5d,
-	0x73, 0x9b, 0x78, 0x5f, 0xd6, 0x56, 0xb5, 0x92, 0x0b, 0x2a, 0x8c, 0x08, 0x75, 0x68, 0x0e, 0x92,
-	0x4b, 0xf8, 0x04, 0xd8, 0x8c, 0xf4, 0x08, 0x13, 0x24, 0x56, 0x87, 0x63, 0xa3, 0x91, 0x0c, 0x1f,
-	0x03, 0x3b, 0xc1, 0x3c, 0xec, 0x72, 0x12, 0xeb, 0x93, 0x40, 0xab, 0x09, 0xe6, 0x5f, 0x71, 0x12,
-	0x7f, 0x66, 0xfd, 0xf9, 0x6f, 0xde, 0x82, 0x8f, 0x41, 0xed, 0x30, 0x8a, 0x08, 0xe7, 0x17, 0xdd,
-	0x4e, 0x46, 0xfe, 0x47, 0x87, 0xed, 0x03, 0x87, 0x0b, 0xca, 0x70, 0x42, 0xc2, 0x2b, 0x32, 0x30,
-	0x7d, 0xa6, 0xbb, 0xc6, 0xe8, 0x7f, 0x4d, 0x06, 0x1c, 0x4d, 0x0a, 0x86, 0xe2, 0xfb, 0x0a, 0xa8,
-	0x5d, 0x30, 0x1c, 0x11, 0xf3, 0xd2, 0x29, 0x7b, 0x55, 0x8a, 0xcc, 0x50, 0x18, 0x49, 0x72, 0x8b,
-	0x34, 0x27, 0xb4, 0x2b, 0xcc, 0xf3, 0x34, 0x14, 0x65, 0x04, 0x23, 0xa4, 0x4f, 0x22, 0x55, 0x46,
-	0x0b, 0x19, 0x09, 0x1e, 0x80, 0xb5, 0x38, 0xe5, 0xea, 0xe5, 0x9f, 0x0b, 0x1c, 0x5d, 0xe9, 0xf4,
-	0x03, 0xf7, 0xa6, 0xf4, 0x1c, 0x63, 0x38, 0x97, 0x7a, 0x34, 0x25, 0xc1, 0x97, 0xa0, 0x31, 0x0e,
-	0x53, 0xbb, 0x55, 0xb5, 0xb1, 0x03, 0x78, 0x53, 0x7a, 0xf5, 0x91, 0xab, 0xb2, 0xa0, 0x19, 0x59,
-	0x9e, 0x74, 0x4c, 0xda, 0xdd, 0x44, 0x35, 0x9f, 0x8d, 0xb4, 0x20, 0xb5, 0x59, 0x9a, 0xa7, 0x42,
-	0x35, 0xdb, 0x32, 0xd2, 0x02, 0x7c, 0x09, 0xaa, 0xb4, 0x47, 0x18, 0x4b, 0x63, 0xc2, 0xd5, 0xf4,
-	0xfd, 0x7f, 0x5f, 0x0e, 0x68, 0xec, 0x2f, 0x93, 0x33, 0x1f, 0x36, 0x39, 0xc9, 0x29, 0x1b, 0xa8,
-	0x71, 0x6a, 0x92, 0xd3, 0x86, 0x37, 0x4a, 0x8f, 0xa6, 0x24, 0x18, 0x00, 0x68, 0xc2, 0x18, 0x11,
-	0x5d, 0x56, 0x84, 0xea, 0xf9, 0x77, 0x54, 0xac, 0x7a, 0x0a, 0xb5, 0x15, 0x29, 0xe3, 0x2b, 0x2c,
-	0x30, 0xba, 0xa3, 0x39, 0xb5, 0x6c, 0xcb, 0x5d, 0x3e, 0xb5, 0xec, 0x55, 0xd7, 0x1e, 0xe5, 0x6f,
-	0x76, 0x81, 0x9a, 0x43, 0x79, 0x02, 0xde, 0xff, 0xcf, 0x22, 0x70, 0x67, 0x3f, 0x40, 0xe0, 0x16,
-	0x70, 0x72, 0x9e, 0x84, 0xf2, 0xca, 0x0e, 0xbb, 0x2c, 0x33, 0xa7, 0x0d, 0x72, 0x9e, 0x5c, 0x0c,
-	0x3a, 0xe4, 0x2b, 0x96, 0xc1, 0x67, 0xa0, 0x29, 0x3d, 0xd4, 0xb5, 0xa9, 0xfd, 0x0a, 0x9c, 0x0f,
-	0x6f, 0x53, 0x37, 0xe7, 0xc9, 0x6f, 0xa5, 0x45, 0x7a, 0xbf, 0xc5, 0x39, 0x81, 0xa7, 0xa0, 0x36,
-	0x76, 0x95, 0x8f, 0x94, 0xbc, 0x38, 0x7f, 0xfa, 0xa1, 0x8f, 0xa4, 0x37, 0x3c, 0x39, 0x14, 0x82,
-	0xc9, 0xe8, 0xc0, 0x92, 0x0f, 0x15, 0x02, 0xbd, 0x21, 0x1c, 0x87, 0x6f, 0x81, 0x53, 0xc8, 0x4f,
-	0x93, 0xd8, 0x80, 0x59, 0x0a, 0xec, 0x67, 0x1f, 0x02, 0x7b, 0xab, 0x7c, 0xdf, 0xe8, 0xad, 0x1b,
-	0xb8, 0x9a, 0x06, 0x50, 0x78, 0xfe, 0x37, 0xa0, 0x79, 0x8f, 0xa7, 0xbc, 0x7f, 0x55, 0x4a, 0xe6,
-	0xe2, 0x97, 0x6b, 0xf8, 0x2b, 0xb0, 0x8c, 0x85, 0x60, 0xc3, 0x9b, 0x7f, 0x8e, 0x04, 0x74, 0x9c,
-	0xff, 0x12, 0xac, 0xdf, 0xf1, 0xb8, 0x97, 0x09, 0x02, 0x4b, 0x66, 0x67, 0x0a, 0xaa, 0xd6, 0x41,
-	0xf0, 0xed, 0xf5, 0xe6, 0xe2, 0x77, 0xd7, 0x9b, 0x8b, 0xdf, 0x5f, 0x6f, 0x2e, 0xfe, 0xf5, 0xfd,
-	0xe6, 0xc2, 0x77, 0xef, 0x37, 0x17, 0xfe, 0xf5, 0x7e, 0x73, 0xe1, 0xf7, 0xdb, 0x13, 0x93, 0x57,
-	0x5c, 0x62, 0xc6, 0x53, 0xbe, 0x3b, 0xfe, 0xeb, 0xa0, 0xaf, 0xfe, 0x3c, 0x50, 0xc5, 0x6a, 0xaf,
-	0xa8, 0x3f, 0x05, 0x3e, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe8, 0xff, 0x38, 0xa9, 0x5a,
-	0x10, 0x00, 0x00,
+	// 1702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x4f, 0xe4, 0xc8,
+	0x19, 0x1e, 0x68, 0x03, 0xee, 0xb2, 0xe9, 0x36, 0xd5, 0xec, 0xa4, 0x67, 0x46, 0xc1, 0xc4, 0x51,
+	0x22, 0x22, 0xed, 0xc0, 0xc2, 0x0a, 0xcd, 0x68, 0x47, 0x51, 0x84, 0x19, 0x76, 0x17, 0x32, 0x33,
+	0x41, 0x35, 0x6c, 0x22, 0x45, 0x8a, 0xac, 0x6a, 0xbb, 0xd6, 0x78, 0xb0, 0x5d, 0xad, 0xaa, 0xea,
+	0x9e, 0xee, 0x28, 0x3f, 0x20, 0x52, 0x2e, 0xf9, 0x09, 0x39, 0xe4, 0x0f, 0xe4, 0x3f, 0xe4, 0xb0,
+	0xca, 0x69, 0x8f, 0x51, 0x0e, 0x56, 0xc4, 0xdc, 0x38, 0xf2, 0x07, 0x12, 0xd5, 0x47, 0x7f, 0xc2,
+	0xae, 0x16, 0x4e, 0x5d, 0xef, 0xd7, 0xf3, 0xd4, 0xfb, 0xd6, 0x5b, 0x1f, 0x6e, 0xf0, 0x98, 0x88,
+	0x73, 0xc2, 0x8a, 0xac, 0x14, 0x3b, 0xa4, 0x5f, 0xec, 0xf4, 0x77, 0xe5, 0xcf, 0x76, 0x97, 0x51,
+	0x41, 0xa1, 0x37, 0xb6, 0x6d, 0x4b, 0x65, 0x7f, 0xf7, 0xf1, 0x7a, 0x4a, 0x53, 0xaa, 0x8c, 0x3b,
+	0x72, 0xa4, 0xfd, 0x82, 0x7f, 0xd6, 0xc0, 0xf2, 0x29, 0x66, 0xb8, 0xe0, 0x70, 0x17, 0xd4, 0x49,
+	0xbf, 0x88, 0x12, 0x52, 0xd2, 0xa2, 0xbd, 0xb0, 0xb9, 0xb0, 0x55, 0x0f, 0xd7, 0xaf, 0x2b, 0xdf,
+	0x1b, 0xe2, 0x22, 0xff, 0x2c, 0x18, 0x9b, 0x02, 0x64, 0x93, 0x7e, 0xf1, 0x52, 0x0e, 0xe1, 0x2f,
+	0xc1, 0x2a, 0x29, 0x71, 0x27, 0x27, 0x51, 0xcc, 0x08, 0x16, 0xa4, 0xbd, 0xb8, 0xb9, 0xb0, 0x65,
+	0x87, 0xed, 0xeb, 0xca, 0x5f, 0x37, 0x61, 0xd3, 0xe6, 0x00, 0xb9, 0x5a, 0x3e, 0x54, 0x22, 0x7c,
+	0x06, 0x9c, 0x91, 0x1d, 0xe7, 0x79, 0xbb, 0xa6, 0x82, 0x1f, 0x5e, 0x57, 0x3e, 0x9c, 0x0d, 0xc6,
+	0x79, 0x1e, 0x20, 0x60, 0x42, 0x71, 0x9e, 0xc3, 0x03, 0x00, 0xc8, 0x40, 0x30, 0x1c, 0x91, 0xac,
+	0xcb, 0xdb, 0xd6, 0x66, 0x6d, 0xab, 0x16, 0x06, 0x97, 0x95, 0x5f, 0x3f, 0x92, 0xda, 0xa3, 0xe3,
+	0x53, 0x7e, 0x5d, 0xf9, 0x6b, 0x06, 0x64, 0xec, 0x18, 0xa0, 0xba, 0x12, 0x8e, 0xb2, 0x2e, 0x87,
+	0x7f, 0x00, 0x6e, 0x7c, 0x8e, 0xb3, 0x32, 0x8a, 0x69, 0xf9, 0x75, 0x96, 0xb6, 0x97, 0x36, 0x17,
+	0xb6, 0x9c, 0xbd, 0x1f, 0x6f, 0xcf, 0xd7, 0x6d, 0xfb, 0x50, 0x7a, 0x1d, 0x2a, 0xa7, 0xf0, 0xc9,
+	0x37, 0x95, 0xff, 0xe0, 0xba, 0xf2, 0x5b, 0x1a, 0x7a, 0x1a, 0x20, 0x40, 0x4e, 0x3c, 0xf1, 0x84,
+	0x05, 0x68, 0x91, 0xac, 0xfb, 0x6c, 0x77, 0x2f, 0xc2, 0x79, 0x4e, 0xdf, 0x93, 0x24, 0x2a, 0x78,
+	0xca, 0xdb, 0xcb, 0x9b, 0xb5, 0x2d, 0x67, 0x2f, 0xb8, 0xc9, 0x72, 0x74, 0x7c, 0xfa, 0x6c, 0x77,
+	0xef, 0x40, 0xfb, 0xbe, 0xe6, 0x69, 0xf8, 0x48, 0x52, 0x5d, 0x56, 0xfe, 0xda, 0xbc, 0x85, 0xa3,
+	0x35, 0x8d, 0x3c, 0xa5, 0x0a, 0xfe, 0xd1, 0x00, 0xce, 0xe1, 0x0c, 0x7d, 0xf3, 0x9c, 0x16, 0x84,
+	0x0b, 0x82, 0x93, 0xa8, 0x93, 0xd3, 0xf8, 0xc2, 0xac, 0xe8, 0xcb, 0xff, 0x54, 0xfe, 0xcf, 0xd3,
+	0x4c, 0x9c, 0xf7, 0x3a, 0xdb, 0x31, 0x2d, 0x76, 0x62, 0xca, 0x0b, 0xca, 0xcd, 0xcf, 0x53, 0x9e,
+	0x5c, 0xec, 0x88, 0x61, 0x97, 0xf0, 0xed, 0xe3, 0x52, 0x5c, 0x57, 0xfe, 0x43, 0x9d, 0xe7, 0x1c,
+	0x54, 0x80, 0x1a, 0x63, 0x4d, 0x28, 0x15, 0x70, 0x08, 0x1a, 0x09, 0xa6, 0xd1, 0xd7, 0x94, 0x5d,
+	0x18, 0xb6, 0x45, 0xc5, 0xf6, 0xf6, 0x87, 0xb3, 0x5d, 0x56, 0xbe, 0xfb, 0xf2, 0xe0, 0x37, 0x9f,
+	0x53, 0x76, 0xa1, 0x30, 0xaf, 0x2b, 0xff, 0x23, 0xcd, 0x3e, 0x8b, 0x1c, 0x20, 0x37, 0xc1, 0x74,
+	0xec, 0x06, 0x7f, 0x07, 0xbc, 0xb1, 0x03, 0xef, 0x75, 0xbb, 0x94, 0x09, 0xd3, 0x48, 0x4f, 0x2f,
+	0x2b, 0xbf, 0x61, 0x20, 0xdf, 0x6a, 0xcb, 0x75, 0xe5, 0xff, 0x68, 0x0e, 0xd4, 0xc4, 0x04, 0xa8,
+	0x61, 0x60, 0x8d, 0x2b, 0xe4, 0xc0, 0x25, 0x59, 0x77, 0x77, 0xff, 0x13, 0x93, 0x91, 0xa5, 0x32,
+	0x3a, 0xbd, 0x53, 0x46, 0xce, 0xd1, 0xf1, 0xe9, 0xee, 0xfe, 0x27, 0xa3, 0x84, 0x4c, 0xdb, 0x4c,
+	0xc3, 0x06, 0xc8, 0xd1, 0xa2, 0xce, 0xe6, 0x18, 0x18, 0x31, 0x3a, 0xc7, 0xfc, 0x5c, 0x35, 0x65,
+	0x3d, 0xdc, 0xba, 0xac, 0x7c, 0xa0, 0x91, 0xbe, 0xc4, 0xfc, 0x7c, 0xb2, 0x2e, 0x9d, 0xe1, 0x1f,
+	0x71, 0x29, 0xb2, 0x5e, 0x31, 0xc2, 0x02, 0x3a, 0x58, 0x7a, 0x8d, 0xe7, 0xbf, 0x6f, 0xe6, 0xbf,
+	0x7c, 0xef, 0xf9, 0xef, 0xdf, 0x36, 0xff, 0xfd, 0xd9, 0xf9, 0x6b, 0x9f, 0x31, 0xe9, 0x73, 0x43,
+	0xba, 0x72, 0x6f, 0xd2, 0xe7, 0xb7, 0x91, 0x3e, 0x9f, 0x25, 0xd5, 0x3e, 0xb2, 0xd9, 0xe7, 0x2a,
+	0xd1, 0xb6, 0xef, 0xdf, 0xec, 0x37, 0x8a, 0xda, 0x18, 0x6b, 0x34, 0xdd, 0x9f, 0xc0, 0x7a, 0x4c,
+	0x4b, 0x2e, 0xa4, 0xae, 0xa4, 0xdd, 0x9c, 0x18, 0xce, 0xba, 0xe2, 0x3c, 0xbe, 0x13, 0xe7, 0x13,
+	0x73, 0x90, 0xdc, 0x82, 0x17, 0xa0, 0xd6, 0xac, 0x5a, 0xb3, 0x77, 0x81, 0xd7, 0x25, 0x82, 0x30,
+	0xde, 0xe9, 0xb1, 0xd4, 0x30, 0x03, 0xc5, 0x7c, 0x74, 0x27, 0x66, 0xb3, 0x0f, 0xe6, 0xb1, 0x02,
+	0xd4, 0x9c, 0xa8, 0x34, 0xe3, 0x3b, 0xd0, 0xc8, 0xe4, 0x34, 0x3a, 0xbd, 0xdc, 0xf0, 0x39, 0x8a,
+	0xef, 0xf0, 0x4e, 0x7c, 0x66, 0x33, 0xcf, 0x22, 0x05, 0x68, 0x75, 0xa4, 0xd0, 0x5c, 0x3d, 0x00,
+	0x8b, 0x5e, 0xc6, 0xa2, 0x34, 0xc7, 0x71, 0x46, 0x98, 0xe1, 0x73, 0x15, 0xdf, 0x17, 0x77, 0xe2,
+	0x7b, 0xa4, 0xf9, 0x6e, 0xa2, 0x05, 0xc8, 0x93, 0xca, 0x2f, 0xb4, 0x4e, 0xd3, 0x26, 0xc0, 0xed,
+	0x10, 0x96, 0x67, 0xa5, 0x21, 0x5c, 0x55, 0x84, 0x07, 0x77, 0x22, 0x34, 0x7d, 0x3a, 0x8d, 0x13,
+	0x20, 0x47, 0x8b, 0x63, 0x96, 0x9c, 0x96, 0x09, 0x1d, 0xb1, 0xac, 0xdd, 0x9f, 0x65, 0x1a, 0x27,
+	0x40, 0x8e, 0x16, 0x35, 0xcb, 0x00, 0xb4, 0x30, 0x63, 0xf4, 0xfd, 0x5c, 0x0d, 0xa1, 0x22, 0xfb,
+	0xf2, 0x4e, 0x64, 0x8f, 0x35, 0xd9, 0x2d, 0x70, 0x01, 0x5a, 0x53, 0xda, 0x99, 0x2a, 0x52, 0xe0,
+	0x15, 0x84, 0xa5, 0x64, 0xfa, 0x1e, 0x68, 0xdd, 0xbf, 0x35, 0xe7, 0xb1, 0x02, 0xd4, 0x50, 0xaa,
+	0xf1, 0xd9, 0x7f, 0x62, 0xd9, 0x0d, 0xaf, 0x79, 0x62, 0xd9, 0x4d, 0xcf, 0x3b, 0xb1, 0x6c, 0xcf,
+	0x5b, 0x43, 0xab, 0x43, 0x9a, 0xd3, 0xa8, 0xff, 0xa9, 0x8e, 0x40, 0x0e, 0x79, 0x8f, 0xb9, 0xd9,
+	0xc8, 0xa8, 0x11, 0x63, 0x81, 0xf3, 0x21, 0x17, 0x06, 0x6e, 0x07, 0x2c, 0xbd, 0x15, 0xf2, 0x19,
+	0xe2, 0x81, 0xda, 0x05, 0x19, 0xea, 0x0b, 0x12, 0xc9, 0x21, 0x5c, 0x07, 0x4b, 0x7d, 0x9c, 0xf7,
+	0xf4, 0x7b, 0xa6, 0x8e, 0xb4, 0x10, 0x9c, 0x82, 0xe6, 0x19, 0xc3, 0x25, 0xc7, 0xb1, 0xc8, 0x68,
+	0xf9, 0x8a, 0xa6, 0x1c, 0x42, 0x60, 0xa9, 0x83, 0x5a, 0xc7, 0xaa, 0x31, 0xfc, 0x05, 0xb0, 0x72,
+	0x9a, 0xf2, 0xf6, 0xa2, 0xba, 0xeb, 0x3f, 0xba, 0x79, 0xd7, 0xbf, 0xa2, 0x29, 0x52, 0x2e, 0xc1,
+	0xbf, 0x16, 0x41, 0xed, 0x15, 0x4d, 0x61, 0x1b, 0xac, 0xe0, 0x24, 0x61, 0x84, 0x73, 0x83, 0x34,
+	0x12, 0xe1, 0x43, 0xb0, 0x2c, 0x68, 0x37, 0x8b, 0x35, 0x5c, 0x1d, 0x19, 0x49, 0x12, 0x27, 0x58,
+	0x60, 0x75, 0xd5, 0xb9, 0x48, 0x8d, 0xe1, 0x1e, 0x70, 0x55, 0x66, 0x51, 0xd9, 0x2b, 0x3a, 0x84,
+	0xa9, 0x1b, 0xcb, 0x0a, 0x9b, 0x57, 0x95, 0xef, 0x28, 0xfd, 0x1b, 0xa5, 0x46, 0xd3, 0x02, 0xfc,
+	0x18, 0xac, 0x88, 0xc1, 0xf4, 0x65, 0xd3, 0xba, 0xaa, 0xfc, 0xa6, 0x98, 0xa4, 0x29, 0xef, 0x12,
+	0xb4, 0x2c, 0x06, 0xf2, 0x17, 0xee, 0x00, 0x5b, 0x0c, 0xa2, 0xac, 0x4c, 0xc8, 0x40, 0xdd, 0x27,
+	0x56, 0xb8, 0x7e, 0x55, 0xf9, 0xde, 0x94, 0xfb, 0xb1, 0xb4, 0xa1, 0x15, 0x31, 0x50, 0x03, 0xf8,
+	0x31, 0x00, 0x7a, 0x4a, 0x8a, 0x41, 0xdf, 0x06, 0xab, 0x57, 0x95, 0x5f, 0x57, 0x5a, 0x85, 0x3d,
+	0x19, 0xc2, 0x00, 0x2c, 0x69, 0x6c, 0x5b, 0x61, 0xbb, 0x57, 0x95, 0x6f, 0xe7, 0x34, 0xd5, 0x98,
+	0xda, 0x24, 0x4b, 0xc5, 0x48, 0x41, 0xfb, 0x24, 0x51, 0x07, 0xae, 0x8d, 0x46, 0x62, 0xf0, 0x97,
+	0x45, 0x60, 0x9f, 0x0d, 0x10, 0xe1, 0xbd, 0x5c, 0xc0, 0xcf, 0x81, 0x17, 0xd3, 0x52, 0x30, 0x1c,
+	0x8b, 0x68, 0xa6, 0xb4, 0xe1, 0x93, 0x49, 0x87, 0xcd, 0x7b, 0x04, 0xa8, 0x39, 0x52, 0x1d, 0x98,
+	0xfa, 0xaf, 0x83, 0xa5, 0x4e, 0x4e, 0x69, 0xa1, 0x3a, 0xc1, 0x45, 0x5a, 0x80, 0x48, 0x55, 0x4d,
+	0xad, 0x72, 0x4d, 0xbd, 0x1b, 0x7f, 0x72, 0x73, 0x95, 0xe7, 0x5a, 0x25, 0x7c, 0x68, 0xde, 0x8e,
+	0x0d, 0xcd, 0x6d, 0xe2, 0x03, 0x59, 0x5b, 0xd5, 0x4a, 0x1e, 0xa8, 0x31, 0x22, 0xd4, 0xa2, 0xb9,
+	0x48, 0x0e, 0xe1, 0x63, 0x60, 0x33, 0xd2, 0x27, 0x4c, 0x90, 0x44, 0x2d, 0x8e, 0x8d, 0xc6, 0x32,
+	0x7c, 0x04, 0xec, 0x14, 0xf3, 0xa8, 0xc7, 0x49, 0xa2, 0x57, 0x02, 0xad, 0xa4, 0x98, 0x7f, 0xc5,
+	0x49, 0xf2, 0x99, 0xf5, 0xe7, 0xbf, 0xf9, 0x0f, 0x02, 0x0c, 0x9c, 0x83, 0x38, 0x26, 0x9c, 0x9f,
+	0xf5, 0xba, 0x39, 0xf9, 0x9e, 0x0e, 0xdb, 0x03, 0x2e, 0x17, 0x94, 0xe1, 0x94, 0x44, 0x17, 0x64,
+	0x68, 0xfa, 0x4c, 0x77, 0x8d, 0xd1, 0xff, 0x9a, 0x0c, 0x39, 0x9a, 0x16, 0x0c, 0xc5, 0xdf, 0x2d,
+	0xe0, 0x9c, 0x31, 0x1c, 0x13, 0xf3, 0xe8, 0x94, 0xbd, 0x2a, 0x45, 0x66, 0x28, 0x8c, 0x24, 0xb9,
+	0x45, 0x56, 0x10, 0xda, 0x13, 0x66, 0x3f, 0x8d, 0x44, 0x19, 0xc1, 0x08, 0x19, 0x90, 0x58, 0x95,
+	0xd1, 0x42, 0x46, 0x82, 0xfb, 0x60, 0x35, 0xc9, 0xb8, 0x7a, 0xfc, 0x73, 0x81, 0xe3, 0x0b, 0x9d,
+	0x7e, 0xe8, 0x5d, 0x55, 0xbe, 0x6b, 0x0c, 0x6f, 0xa5, 0x1e, 0xcd, 0x48, 0xf0, 0x05, 0x68, 0x4e,
+	0xc2, 0xd4, 0x6c, 0x55, 0x6d, 0xec, 0x10, 0x5e, 0x55, 0x7e, 0x63, 0xec, 0xaa, 0x2c, 0x68, 0x4e,
+	0x96, 0x2b, 0x9d, 0x90, 0x4e, 0x2f, 0x55, 0xcd, 0x67, 0x23, 0x2d, 0x48, 0x6d, 0x9e, 0x15, 0x99,
+	0x50, 0xcd, 0xb6, 0x84, 0xb4, 0x00, 0x5f, 0x80, 0x3a, 0xed, 0x13, 0xc6, 0xb2, 0x84, 0xf0, 0x36,
+	0xf8, 0x01, 0x5f, 0x0e, 0x68, 0xe2, 0x2f, 0x93, 0x33, 0x1f, 0x36, 0x05, 0x29, 0x28, 0x1b, 0xb6,
+	0x9d, 0x49, 0x72, 0xda, 0xf0, 0x5a, 0xe9, 0xd1, 0x8c, 0x04, 0x43, 0x00, 0x4d, 0x18, 0x23, 0xa2,
+	0xc7, 0xca, 0x48, 0xed, 0x7f, 0x57, 0xc5, 0xaa, 0x5d, 0xa8, 0xad, 0x48, 0x19, 0x5f, 0x62, 0x81,
+	0xd1, 0x0d, 0x8d, 0xc4, 0xd0, 0x6b, 0x12, 0xbd, 0xe3, 0x74, 0xfc, 0xe9, 0xa3, 0x6f, 0xbb, 0xd1,
+	0x4e, 0x8e, 0x09, 0x3b, 0xe1, 0x74, 0x34, 0xef, 0x1b, 0x9a, 0x13, 0xcb, 0xb6, 0xbc, 0xa5, 0x13,
+	0xcb, 0x5e, 0xf1, 0xec, 0x71, 0x0d, 0x4d, 0x26, 0xa8, 0x35, 0x92, 0xa7, 0xa6, 0x18, 0xfc, 0x6f,
+	0x01, 0x78, 0xf3, 0x1f, 0x31, 0x70, 0x13, 0xb8, 0x05, 0x4f, 0x23, 0x79, 0xec, 0x47, 0x3d, 0x96,
+	0x9b, 0x8e, 0x01, 0x05, 0x4f, 0xcf, 0x86, 0x5d, 0xf2, 0x15, 0xcb, 0xe1, 0x53, 0xd0, 0x92, 0x1e,
+	0xea, 0xe8, 0xd5, 0x7e, 0x25, 0x2e, 0x46, 0x27, 0xb2, 0x57, 0xf0, 0xf4, 0xb7, 0xd2, 0x22, 0xbd,
+	0xdf, 0xe0, 0x82, 0xc0, 0x13, 0xe0, 0x4c, 0x5c, 0xe5, 0xb6, 0x94, 0x87, 0xef, 0x4f, 0xbf, 0xeb,
+	0x43, 0xeb, 0x35, 0x4f, 0x0f, 0x84, 0x60, 0x32, 0x3a, 0xb4, 0xe4, 0xc6, 0x44, 0xa0, 0x3f, 0x82,
+	0xe3, 0xf0, 0x0d, 0x70, 0x4b, 0xc2, 0x05, 0x49, 0x0c, 0x98, 0xa5, 0xc0, 0x7e, 0xf6, 0x5d, 0x60,
+	0x6f, 0x94, 0xef, 0x6b, 0x9e, 0x4e, 0xc1, 0x39, 0x1a, 0x40, 0xe1, 0x05, 0xef, 0x40, 0xeb, 0x16,
+	0x4f, 0x79, 0x86, 0xab, 0x94, 0xcc, 0xe5, 0x21, 0xc7, 0xf0, 0x57, 0x60, 0x09, 0x0b, 0xc1, 0x46,
+	0xb7, 0xc7, 0x1d, 0x12, 0xd0, 0x71, 0xc1, 0x0b, 0xb0, 0x76, 0xc3, 0xe3, 0x56, 0x26, 0x08, 0x2c,
+	0x99, 0x9d, 0x29, 0xa8, 0x1a, 0x87, 0xe1, 0x37, 0x97, 0x1b, 0x0b, 0xdf, 0x5e, 0x6e, 0x2c, 0xfc,
+	0xf7, 0x72, 0x63, 0xe1, 0xaf, 0x1f, 0x36, 0x1e, 0x7c, 0xfb, 0x61, 0xe3, 0xc1, 0xbf, 0x3f, 0x6c,
+	0x3c, 0xf8, 0xfd, 0xd6, 0xd4, 0xed, 0x2d, 0xce, 0x31, 0xe3, 0x19, 0xdf, 0x99, 0xfc, 0xfd, 0x30,
+	0x50, 0x7f, 0x40, 0xa8, 0x62, 0x75, 0x96, 0xd5, 0x1f, 0x0b, 0x9f, 0xfe, 0x7f, 0x00, 0xe2, 0x88,
+	0x79, 0x8c, 0x9e, 0x10, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1560,6 +1571,13 @@ func (m *TraceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TracerJsonConfig) > 0 {
+		i -= len(m.TracerJsonConfig)
+		copy(dAtA[i:], m.TracerJsonConfig)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.TracerJsonConfig)))
+		i--
+		dAtA[i] = 0x6a
+	}
 	if m.EnableReturnData {
 		i--
 		if m.EnableReturnData {
@@ -2078,6 +2096,10 @@ func (m *TraceConfig) Size() (n int) {
 	if m.EnableReturnData {
 		n += 2
 	}
+	l = len(m.TracerJsonConfig)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
 	return n
 }
 
@@ -4145,6 +4167,38 @@ func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableReturnData = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TracerJsonConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TracerJsonConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
@@ -2,6 +2,8 @@ package types
 
 import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 )
 
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
@@ -22,3 +24,17 @@ func (m QueryTraceBlockRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker
 	}
 	return nil
 }
+
+// Revert returns the data returned by the reverted execution if the gas estimation failed on a revert, the
+// reason can be nil if no data was supplied with the revert opcode.
+func (m *EstimateGasResponse) Revert() []byte {
+	if m.VmError != vm.ErrExecutionReverted.Error() {
+		return nil
+	}
+	return common.CopyBytes(m.Ret)
+}
+
+// Failed returns true if the gas estimation failed on a revert.
+func (m *EstimateGasResponse) Failed() bool {
+	return len(m.VmError) > 0
+}
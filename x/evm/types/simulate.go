@@ -0,0 +1,22 @@
+package types
+
+import "encoding/json"
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks simulated by a SimulateCalls query, like in geth.
+	MaxSimulateBlocks = 256
+	// MaxSimulateCalls is the maximum number of calls simulated by a SimulateCalls query, over all the blocks.
+	MaxSimulateCalls = 1000
+)
+
+// SimBlock is a group of calls simulated after applying the state overrides,
+// the state changes of the calls are visible to the next ones.
+// Same json format as the blockStateCalls of eth_simulateV1.
+// Ref: https://github.com/ethereum/execution-apis/blob/main/src/eth/execute.yaml
+type SimBlock struct {
+	// BlockOverrides are not supported, the calls are simulated in the context
+	// of the queried block.
+	BlockOverrides json.RawMessage   `json:"blockOverrides,omitempty"`
+	StateOverrides *StateOverride    `json:"stateOverrides,omitempty"`
+	Calls          []TransactionArgs `json:"calls"`
+}
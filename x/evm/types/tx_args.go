@@ -233,6 +233,18 @@ func (args *TransactionArgs) GetFrom() common.Address {
 	return *args.From
 }
 
+// GetFeeCap retrieves the highest price the sender pays per gas, the legacy gas
+// price is preferred. It returns nil if no price is set.
+func (args *TransactionArgs) GetFeeCap() *big.Int {
+	if args.GasPrice != nil {
+		return args.GasPrice.ToInt()
+	}
+	if args.MaxFeePerGas != nil {
+		return args.MaxFeePerGas.ToInt()
+	}
+	return nil
+}
+
 // GetData retrieves the transaction calldata. Input field is preferred.
 func (args *TransactionArgs) GetData() []byte {
 	if args.Input != nil {
@@ -39,6 +39,25 @@ func DecodeTxResponse(in []byte) (*MsgEthereumTxResponse, error) {
 	return &res, nil
 }
 
+// DecodeTxResponses decodes the protobuf-encoded responses of all the msgs of a tx
+func DecodeTxResponses(in []byte) ([]*MsgEthereumTxResponse, error) {
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(in, &txMsgData); err != nil {
+		return nil, err
+	}
+
+	responses := make([]*MsgEthereumTxResponse, 0, len(txMsgData.Data))
+	for _, data := range txMsgData.Data {
+		var res MsgEthereumTxResponse
+		if err := proto.Unmarshal(data.GetData(), &res); err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to unmarshal tx response message data")
+		}
+		responses = append(responses, &res)
+	}
+
+	return responses, nil
+}
+
 // EncodeTransactionLogs encodes TransactionLogs slice into a protobuf-encoded byte slice.
 func EncodeTransactionLogs(res *TransactionLogs) ([]byte, error) {
 	return proto.Marshal(res)
@@ -45,6 +45,25 @@ func TestEvmDataEncoding(t *testing.T) {
 	require.NotNil(t, res)
 	require.Equal(t, data.Logs, res.Logs)
 	require.Equal(t, ret, res.Ret)
+
+	// batch tx, the responses are returned in the msg order
+	reverted := &evmtypes.MsgEthereumTxResponse{
+		Hash:    common.BytesToHash([]byte("hash2")).String(),
+		Ret:     []byte{0x9},
+		VmError: "execution reverted",
+	}
+	enc2, err := proto.Marshal(reverted)
+	require.NoError(t, err)
+	txData.Data = append(txData.Data, &sdk.MsgData{MsgType: evmtypes.TypeMsgEthereumTx, Data: enc2})
+
+	txDataBz, err = proto.Marshal(txData)
+	require.NoError(t, err)
+
+	responses, err := evmtypes.DecodeTxResponses(txDataBz)
+	require.NoError(t, err)
+	require.Len(t, responses, 2)
+	require.Equal(t, ret, responses[0].Ret)
+	require.Equal(t, reverted.Ret, responses[1].Revert())
 }
 
 func TestUnwrapEthererumMsg(t *testing.T) {